@@ -0,0 +1,115 @@
+package metakube
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/addon"
+	"github.com/syseleven/go-metakube/client/operations"
+)
+
+func dataSourceMetakubeAddonConfigs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeAddonConfigsRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return config of the addon with this name",
+			},
+			"configs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Configs of accessible addons",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Addon name",
+						},
+						"short_description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Short description of the addon",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the addon",
+						},
+						"logo": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Base64 encoded logo of the addon",
+						},
+						"logo_format": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Format of the logo, e.g. svg+xml or png",
+						},
+						"variables": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Variables the addon can be configured with",
+							Elem: &schema.Resource{
+								Schema: metakubeAddonVariableFields(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeAddonConfigsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+
+	ar, err := k.client.Addon.ListAccessibleAddons(addon.NewListAccessibleAddonsParams().WithContext(ctx), k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list accessible addons: %s", stringifyResponseError(err))
+	}
+	r, err := k.client.Operations.ListAddonConfigs(operations.NewListAddonConfigsParams().WithContext(ctx), k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list addon configs: %s", stringifyResponseError(err))
+	}
+
+	accessible := make(map[string]bool, len(ar.Payload))
+	for _, v := range ar.Payload {
+		accessible[v] = true
+	}
+	name := d.Get("name").(string)
+	configs := make([]interface{}, 0, len(r.Payload))
+	for _, v := range r.Payload {
+		if v == nil || v.Spec == nil || !accessible[v.Name] || !time.Time(v.DeletionTimestamp).IsZero() {
+			continue
+		}
+		if name != "" && v.Name != name {
+			continue
+		}
+		configs = append(configs, map[string]interface{}{
+			"name":              v.Name,
+			"short_description": v.Spec.ShortDescription,
+			"description":       v.Spec.Description,
+			"logo":              v.Spec.Logo,
+			"logo_format":       v.Spec.LogoFormat,
+			"variables":         metakubeFlattenAddonVariables(v.Spec.Controls),
+		})
+	}
+
+	// Result depends on the token and the name filter only.
+	if name != "" {
+		d.SetId(name)
+	} else {
+		d.SetId("addon_configs")
+	}
+	if err := d.Set("configs", configs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
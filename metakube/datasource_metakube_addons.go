@@ -0,0 +1,162 @@
+package metakube
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/addon"
+	"github.com/syseleven/go-metakube/client/operations"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func metakubeAddonVariableFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Variable name",
+		},
+		"display_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Human readable variable name",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Type of the form control, e.g. text, number or boolean",
+		},
+		"required": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the variable must be set",
+		},
+		"help_text": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Help text of the variable",
+		},
+	}
+}
+
+func dataSourceMetakubeAddons() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeAddonsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Project the cluster belongs to",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster to list installable addons of",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Sorted names of addons installable in the cluster",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"addons": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Addons installable in the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Addon name",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Short description of the addon",
+						},
+						"variables": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Variables the addon can be configured with",
+							Elem: &schema.Resource{
+								Schema: metakubeAddonVariableFields(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeAddonsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	clusterID := d.Get("cluster_id").(string)
+	projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	p := addon.NewListInstallableAddonsV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(clusterID)
+	r, err := k.client.Addon.ListInstallableAddonsV2(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list installable addons of cluster '%s': %s", clusterID, stringifyResponseError(err))
+	}
+	cr, err := k.client.Operations.ListAddonConfigs(operations.NewListAddonConfigsParams().WithContext(ctx), k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list addon configs: %s", stringifyResponseError(err))
+	}
+
+	configs := make(map[string]*models.AddonConfigSpec, len(cr.Payload))
+	for _, v := range cr.Payload {
+		if v != nil && v.Spec != nil {
+			configs[v.Name] = v.Spec
+		}
+	}
+	names := append([]string(nil), r.Payload...)
+	sort.Strings(names)
+	addons := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		att := map[string]interface{}{
+			"name": name,
+		}
+		if spec, ok := configs[name]; ok {
+			att["description"] = spec.ShortDescription
+			att["variables"] = metakubeFlattenAddonVariables(spec.Controls)
+		}
+		addons = append(addons, att)
+	}
+
+	d.SetId(clusterID)
+	_ = d.Set("project_id", projectID)
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("addons", addons); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func metakubeFlattenAddonVariables(in []*models.AddonFormControl) []interface{} {
+	ret := make([]interface{}, 0, len(in))
+	for _, v := range in {
+		if v == nil {
+			continue
+		}
+		ret = append(ret, map[string]interface{}{
+			"name":         v.InternalName,
+			"display_name": v.DisplayName,
+			"type":         v.Type,
+			"required":     v.Required,
+			"help_text":    v.HelpText,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,88 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/models"
+)
+
+// metakubeAWSCredentials are arguments AWS resources can be listed with.
+func metakubeAWSCredentials() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"access_key_id": {
+			Type:        schema.TypeString,
+			Description: "AWS access key ID",
+		},
+		"secret_access_key": {
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Description: "AWS secret access key",
+		},
+	}
+}
+
+// metakubeAWSScopeFields are arguments shared by AWS data sources that support clusters.
+func metakubeAWSScopeFields() map[string]*schema.Schema {
+	return metakubeCloudScopeFields("AWS", metakubeAWSCredentials(), "access_key_id", "secret_access_key")
+}
+
+// metakubeAWSCredentialsFields are arguments of AWS data sources that can only be listed with explicit credentials.
+func metakubeAWSCredentialsFields() map[string]*schema.Schema {
+	return metakubeCloudCredentialsFields(metakubeAWSCredentials())
+}
+
+// metakubeAWSScope adds AWS credentials, set only for datacenter scope.
+type metakubeAWSScope struct {
+	metakubeCloudScope
+
+	accessKeyID     *string
+	secretAccessKey *string
+}
+
+func metakubeAWSDataSourceScope(ctx context.Context, d *schema.ResourceData, k *metakubeProviderMeta) (*metakubeAWSScope, diag.Diagnostics) {
+	scope, diags := metakubeCloudDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return nil, diags
+	}
+	if scope.clusterScoped() {
+		return &metakubeAWSScope{metakubeCloudScope: scope}, nil
+	}
+	return metakubeAWSCredentialsScope(d), nil
+}
+
+func metakubeAWSCredentialsScope(d *schema.ResourceData) *metakubeAWSScope {
+	return &metakubeAWSScope{
+		metakubeCloudScope: metakubeCloudScope{dcName: d.Get("dc_name").(string)},
+		accessKeyID:        strToPtr(d.Get("access_key_id").(string)),
+		secretAccessKey:    strToPtr(d.Get("secret_access_key").(string)),
+	}
+}
+
+// id returns identifier of the listing for data source id.
+func (s *metakubeAWSScope) id() string {
+	return s.listingID(s.accessKeyID)
+}
+
+func metakubeFlattenAWSTags(in []*models.AWSTag) map[string]interface{} {
+	ret := make(map[string]interface{}, len(in))
+	for _, v := range in {
+		if v != nil {
+			ret[v.Key] = v.Value
+		}
+	}
+	return ret
+}
+
+// metakubeAWSTagsMatch returns true if tags contain all of want.
+func metakubeAWSTagsMatch(tags []*models.AWSTag, want map[string]interface{}) bool {
+	have := metakubeFlattenAWSTags(tags)
+	for k, v := range want {
+		if got, ok := have[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
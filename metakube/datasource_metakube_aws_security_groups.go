@@ -0,0 +1,50 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/aws"
+)
+
+func dataSourceMetakubeAWSSecurityGroups() *schema.Resource {
+	fields := metakubeAWSCredentialsFields()
+	fields["ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "IDs of security groups",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeAWSSecurityGroupsRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeAWSSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope := metakubeAWSCredentialsScope(d)
+
+	p := aws.NewListAWSSecurityGroupsParams().WithContext(ctx).WithDC(scope.dcName)
+	p.AccessKeyID = scope.accessKeyID
+	p.SecretAccessKey = scope.secretAccessKey
+	r, err := k.client.Aws.ListAWSSecurityGroups(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list aws security groups: %s", stringifyResponseError(err))
+	}
+
+	var ids []string
+	if r.Payload != nil {
+		ids = r.Payload.IDs
+	}
+
+	d.SetId(scope.id())
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/aws"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeAWSSubnets() *schema.Resource {
+	fields := metakubeAWSScopeFields()
+	fields["vpc_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"dc_name"},
+		Description:  "VPC to list subnets of, the cluster's VPC is used with cluster_id",
+	}
+	fields["availability_zone"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return subnets in this availability zone",
+	}
+	fields["tags"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Description: "Only return subnets having all of these tags",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	fields["ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "IDs of subnets matching the filters",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	fields["subnets"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Subnets matching the filters",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Subnet ID",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Subnet name",
+				},
+				"availability_zone": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Availability zone of the subnet",
+				},
+				"ipv4_cidr": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "IPv4 CIDR block of the subnet",
+				},
+				"ipv6_cidr": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "IPv6 CIDR block of the subnet",
+				},
+				"available_ip_address_count": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of unused IPv4 addresses in the subnet",
+				},
+				"default_for_az": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the subnet is the default subnet of its availability zone",
+				},
+				"tags": {
+					Type:        schema.TypeMap,
+					Computed:    true,
+					Description: "Subnet tags",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeAWSSubnetsRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeAWSSubnetsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeAWSDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	var all models.AWSSubnetList
+	if scope.clusterScoped() {
+		p := aws.NewListAWSSubnetsNoCredentialsV2Params().WithContext(ctx).WithProjectID(scope.projectID).WithClusterID(scope.clusterID)
+		r, err := k.client.Aws.ListAWSSubnetsNoCredentialsV2(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list aws subnets: %s", stringifyResponseError(err))
+		}
+		all = r.Payload
+	} else {
+		p := aws.NewListAWSSubnetsParams().WithContext(ctx).WithDC(scope.dcName)
+		p.AccessKeyID = scope.accessKeyID
+		p.SecretAccessKey = scope.secretAccessKey
+		if v, ok := d.GetOk("vpc_id"); ok {
+			p.VPC = strToPtr(v.(string))
+		}
+		r, err := k.client.Aws.ListAWSSubnets(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list aws subnets: %s", stringifyResponseError(err))
+		}
+		all = r.Payload
+	}
+
+	az := d.Get("availability_zone").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	ids := make([]interface{}, 0, len(all))
+	subnets := make([]interface{}, 0, len(all))
+	for _, v := range all {
+		if v == nil || (az != "" && v.AvailabilityZone != az) || !metakubeAWSTagsMatch(v.Tags, tags) {
+			continue
+		}
+		ids = append(ids, v.ID)
+		subnets = append(subnets, map[string]interface{}{
+			"id":                         v.ID,
+			"name":                       v.Name,
+			"availability_zone":          v.AvailabilityZone,
+			"ipv4_cidr":                  v.IPV4CIDR,
+			"ipv6_cidr":                  v.IPV6CIDR,
+			"available_ip_address_count": v.AvailableIPAddressCount,
+			"default_for_az":             v.DefaultForAz,
+			"tags":                       metakubeFlattenAWSTags(v.Tags),
+		})
+	}
+
+	d.SetId(scope.id())
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("subnets", subnets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package metakube
+
+import (
+	"testing"
+
+	"github.com/syseleven/go-metakube/models"
+)
+
+func TestMetakubeAWSTagsMatch(t *testing.T) {
+	tags := []*models.AWSTag{
+		{Key: "Name", Value: "private-a"},
+		nil,
+		{Key: "kubernetes.io/role/internal-elb", Value: "1"},
+	}
+
+	cases := []struct {
+		Want     map[string]interface{}
+		Expected bool
+	}{
+		{nil, true},
+		{map[string]interface{}{"Name": "private-a"}, true},
+		{map[string]interface{}{"Name": "private-a", "kubernetes.io/role/internal-elb": "1"}, true},
+		{map[string]interface{}{"Name": "private-b"}, false},
+		{map[string]interface{}{"tier": "private"}, false},
+	}
+	for i, tc := range cases {
+		if got := metakubeAWSTagsMatch(tags, tc.Want); got != tc.Expected {
+			t.Errorf("case %d: want %v, got %v", i, tc.Expected, got)
+		}
+	}
+}
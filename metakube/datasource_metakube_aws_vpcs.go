@@ -0,0 +1,118 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/aws"
+)
+
+func dataSourceMetakubeAWSVPCs() *schema.Resource {
+	fields := metakubeAWSCredentialsFields()
+	fields["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return VPCs with this name",
+	}
+	fields["tags"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Description: "Only return VPCs having all of these tags",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	fields["ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "IDs of VPCs matching the filters",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	fields["vpcs"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "VPCs matching the filters",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "VPC ID",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "VPC name",
+				},
+				"cidr_block": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Primary IPv4 CIDR block of the VPC",
+				},
+				"is_default": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the VPC is the default VPC of the region",
+				},
+				"state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "VPC state",
+				},
+				"tags": {
+					Type:        schema.TypeMap,
+					Computed:    true,
+					Description: "VPC tags",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeAWSVPCsRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeAWSVPCsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope := metakubeAWSCredentialsScope(d)
+
+	p := aws.NewListAWSVPCSParams().WithContext(ctx).WithDC(scope.dcName)
+	p.AccessKeyID = scope.accessKeyID
+	p.SecretAccessKey = scope.secretAccessKey
+	r, err := k.client.Aws.ListAWSVPCS(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list aws vpcs: %s", stringifyResponseError(err))
+	}
+
+	name := d.Get("name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+	ids := make([]interface{}, 0, len(r.Payload))
+	vpcs := make([]interface{}, 0, len(r.Payload))
+	for _, v := range r.Payload {
+		if v == nil || (name != "" && v.Name != name) || !metakubeAWSTagsMatch(v.Tags, tags) {
+			continue
+		}
+		ids = append(ids, v.VpcID)
+		vpcs = append(vpcs, map[string]interface{}{
+			"id":         v.VpcID,
+			"name":       v.Name,
+			"cidr_block": v.CidrBlock,
+			"is_default": v.IsDefault,
+			"state":      v.State,
+			"tags":       metakubeFlattenAWSTags(v.Tags),
+		})
+	}
+
+	d.SetId(scope.id())
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("vpcs", vpcs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/datacenter"
+)
+
+// metakubeAzureCredentials are arguments Azure resources can be listed with.
+func metakubeAzureCredentials() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"client_id": {
+			Type:        schema.TypeString,
+			Description: "Azure client ID",
+		},
+		"client_secret": {
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Description: "Azure client secret",
+		},
+		"subscription_id": {
+			Type:        schema.TypeString,
+			Description: "Azure subscription ID",
+		},
+		"tenant_id": {
+			Type:        schema.TypeString,
+			Description: "Azure tenant ID",
+		},
+	}
+}
+
+// metakubeAzureScopeFields are arguments shared by Azure data sources that support clusters.
+func metakubeAzureScopeFields() map[string]*schema.Schema {
+	return metakubeCloudScopeFields("Azure", metakubeAzureCredentials(), "client_id", "client_secret", "subscription_id", "tenant_id")
+}
+
+// metakubeAzureCredentialsFields are arguments of Azure data sources that can only be listed with explicit credentials.
+func metakubeAzureCredentialsFields() map[string]*schema.Schema {
+	return metakubeCloudCredentialsFields(metakubeAzureCredentials())
+}
+
+// metakubeAzureScope adds Azure credentials and datacenter location, set only for datacenter scope.
+type metakubeAzureScope struct {
+	metakubeCloudScope
+
+	location       *string
+	clientID       *string
+	clientSecret   *string
+	subscriptionID *string
+	tenantID       *string
+}
+
+func metakubeAzureDataSourceScope(ctx context.Context, d *schema.ResourceData, k *metakubeProviderMeta) (*metakubeAzureScope, diag.Diagnostics) {
+	scope, diags := metakubeCloudDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return nil, diags
+	}
+	if scope.clusterScoped() {
+		return &metakubeAzureScope{metakubeCloudScope: scope}, nil
+	}
+	return metakubeAzureCredentialsScope(ctx, d, k)
+}
+
+func metakubeAzureCredentialsScope(ctx context.Context, d *schema.ResourceData, k *metakubeProviderMeta) (*metakubeAzureScope, diag.Diagnostics) {
+	// Azure endpoints are addressed by location rather than datacenter.
+	dcName := d.Get("dc_name").(string)
+	r, err := k.client.Datacenter.GetDatacenter(datacenter.NewGetDatacenterParams().WithContext(ctx).WithDC(dcName), k.auth)
+	if err != nil {
+		return nil, diag.Errorf("unable to get datacenter '%s': %s", dcName, stringifyResponseError(err))
+	}
+	if r.Payload.Spec == nil || r.Payload.Spec.Azure == nil {
+		return nil, diag.Errorf("datacenter '%s' is not an Azure datacenter", dcName)
+	}
+
+	return &metakubeAzureScope{
+		metakubeCloudScope: metakubeCloudScope{dcName: dcName},
+		location:           strToPtr(r.Payload.Spec.Azure.Location),
+		clientID:           strToPtr(d.Get("client_id").(string)),
+		clientSecret:       strToPtr(d.Get("client_secret").(string)),
+		subscriptionID:     strToPtr(d.Get("subscription_id").(string)),
+		tenantID:           strToPtr(d.Get("tenant_id").(string)),
+	}, nil
+}
+
+// id returns identifier of the listing for data source id.
+func (s *metakubeAzureScope) id() string {
+	return s.listingID(s.subscriptionID)
+}
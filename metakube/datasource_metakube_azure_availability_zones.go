@@ -0,0 +1,75 @@
+package metakube
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/azure"
+)
+
+func dataSourceMetakubeAzureAvailabilityZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeAzureAvailabilityZonesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Project the cluster belongs to",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster to use Azure credentials and location of",
+			},
+			"size": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "VM size to list availability zones for",
+			},
+			"zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Availability zones the VM size is supported in",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeAzureAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	clusterID := d.Get("cluster_id").(string)
+	projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+	size := d.Get("size").(string)
+
+	p := azure.NewListAzureAvailabilityZonesNoCredentialsV2Params().
+		WithContext(ctx).
+		WithProjectID(projectID).
+		WithClusterID(clusterID).
+		WithSKUName(strToPtr(size))
+	r, err := k.client.Azure.ListAzureAvailabilityZonesNoCredentialsV2(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list azure availability zones: %s", stringifyResponseError(err))
+	}
+
+	var zones []string
+	if r.Payload != nil {
+		zones = append(zones, r.Payload.Zones...)
+	}
+	sort.Strings(zones)
+
+	d.SetId(clusterID + ":" + size)
+	_ = d.Set("project_id", projectID)
+	if err := d.Set("zones", zones); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
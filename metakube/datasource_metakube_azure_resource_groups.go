@@ -0,0 +1,56 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/azure"
+)
+
+func dataSourceMetakubeAzureResourceGroups() *schema.Resource {
+	fields := metakubeAzureCredentialsFields()
+	fields["names"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Names of resource groups",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeAzureResourceGroupsRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeAzureResourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeAzureCredentialsScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	p := azure.NewListAzureResourceGroupsParams().WithContext(ctx)
+	p.Location = scope.location
+	p.ClientID = scope.clientID
+	p.ClientSecret = scope.clientSecret
+	p.SubscriptionID = scope.subscriptionID
+	p.TenantID = scope.tenantID
+	r, err := k.client.Azure.ListAzureResourceGroups(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list azure resource groups: %s", stringifyResponseError(err))
+	}
+
+	var names []string
+	if r.Payload != nil {
+		names = r.Payload.ResourceGroups
+	}
+
+	d.SetId(scope.id())
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/azure"
+)
+
+func dataSourceMetakubeAzureRouteTables() *schema.Resource {
+	fields := metakubeAzureCredentialsFields()
+	fields["resource_group"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Resource group to list route tables of",
+	}
+	fields["names"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Names of route tables",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeAzureRouteTablesRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeAzureRouteTablesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeAzureCredentialsScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	p := azure.NewListAzureRouteTablesParams().WithContext(ctx)
+	p.Location = scope.location
+	p.ResourceGroup = strToPtr(d.Get("resource_group").(string))
+	p.ClientID = scope.clientID
+	p.ClientSecret = scope.clientSecret
+	p.SubscriptionID = scope.subscriptionID
+	p.TenantID = scope.tenantID
+	r, err := k.client.Azure.ListAzureRouteTables(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list azure route tables: %s", stringifyResponseError(err))
+	}
+
+	var names []string
+	if r.Payload != nil {
+		names = r.Payload.RouteTables
+	}
+
+	d.SetId(scope.id() + ":" + d.Get("resource_group").(string))
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package metakube
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/syseleven/go-metakube/client/azure"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeAzureSizes() *schema.Resource {
+	fields := metakubeAzureScopeFields()
+	fields["name_regex"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+		Description:  "Only return VM sizes with names matching the regular expression",
+	}
+	fields["min_vcpus"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Only return VM sizes with at least this many cores",
+	}
+	fields["min_memory_mb"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Only return VM sizes with at least this much memory in megabytes",
+	}
+	fields["names"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Names of VM sizes matching the filters",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	fields["sizes"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "VM sizes matching the filters",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "VM size name",
+				},
+				"vcpus": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of cores",
+				},
+				"memory_mb": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Memory in megabytes",
+				},
+				"max_data_disk_count": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Maximum number of data disks",
+				},
+				"os_disk_size_mb": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Maximum OS disk size in megabytes",
+				},
+				"resource_disk_size_mb": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Temporary resource disk size in megabytes",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeAzureSizesRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeAzureSizesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeAzureDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	var all models.AzureSizeList
+	if scope.clusterScoped() {
+		p := azure.NewListAzureSizesNoCredentialsV2Params().WithContext(ctx).WithProjectID(scope.projectID).WithClusterID(scope.clusterID)
+		r, err := k.client.Azure.ListAzureSizesNoCredentialsV2(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list azure sizes: %s", stringifyResponseError(err))
+		}
+		all = r.Payload
+	} else {
+		p := azure.NewListAzureSizesParams().WithContext(ctx)
+		p.Location = scope.location
+		p.ClientID = scope.clientID
+		p.ClientSecret = scope.clientSecret
+		p.SubscriptionID = scope.subscriptionID
+		p.TenantID = scope.tenantID
+		r, err := k.client.Azure.ListAzureSizes(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list azure sizes: %s", stringifyResponseError(err))
+		}
+		all = r.Payload
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	filtered := metakubeFilterAzureSizes(all, nameRegex, int32(d.Get("min_vcpus").(int)), int32(d.Get("min_memory_mb").(int)))
+
+	sizes := make([]interface{}, 0, len(filtered))
+	names := make([]interface{}, 0, len(filtered))
+	for _, v := range filtered {
+		sizes = append(sizes, map[string]interface{}{
+			"name":                  v.Name,
+			"vcpus":                 v.NumberOfCores,
+			"memory_mb":             v.MemoryInMB,
+			"max_data_disk_count":   v.MaxDataDiskCount,
+			"os_disk_size_mb":       v.OsDiskSizeInMB,
+			"resource_disk_size_mb": v.ResourceDiskSizeInMB,
+		})
+		names = append(names, v.Name)
+	}
+
+	d.SetId(scope.id())
+	if err := d.Set("sizes", sizes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func metakubeFilterAzureSizes(in []*models.AzureSize, nameRegex *regexp.Regexp, minVCPUs, minMemory int32) []*models.AzureSize {
+	var ret []*models.AzureSize
+	for _, v := range in {
+		if v == nil || v.NumberOfCores < minVCPUs || v.MemoryInMB < minMemory {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(v.Name) {
+			continue
+		}
+		ret = append(ret, v)
+	}
+	return ret
+}
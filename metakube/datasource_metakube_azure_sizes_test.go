@@ -0,0 +1,35 @@
+package metakube
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func TestMetakubeFilterAzureSizes(t *testing.T) {
+	b2s := &models.AzureSize{Name: "Standard_B2s", NumberOfCores: 2, MemoryInMB: 4096}
+	d4s := &models.AzureSize{Name: "Standard_D4s_v3", NumberOfCores: 4, MemoryInMB: 16384}
+	f8s := &models.AzureSize{Name: "Standard_F8s_v2", NumberOfCores: 8, MemoryInMB: 16384}
+	all := []*models.AzureSize{b2s, nil, d4s, f8s}
+
+	cases := []struct {
+		NameRegex *regexp.Regexp
+		MinVCPUs  int32
+		MinMemory int32
+		Expected  []*models.AzureSize
+	}{
+		{nil, 0, 0, []*models.AzureSize{b2s, d4s, f8s}},
+		{nil, 4, 0, []*models.AzureSize{d4s, f8s}},
+		{nil, 0, 8192, []*models.AzureSize{d4s, f8s}},
+		{regexp.MustCompile(`_v3$`), 0, 8192, []*models.AzureSize{d4s}},
+		{nil, 16, 0, nil},
+	}
+	for i, tc := range cases {
+		got := metakubeFilterAzureSizes(all, tc.NameRegex, tc.MinVCPUs, tc.MinMemory)
+		if diff := cmp.Diff(tc.Expected, got); diff != "" {
+			t.Errorf("case %d: mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+}
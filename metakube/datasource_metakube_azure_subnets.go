@@ -0,0 +1,67 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/azure"
+)
+
+func dataSourceMetakubeAzureSubnets() *schema.Resource {
+	fields := metakubeAzureCredentialsFields()
+	fields["resource_group"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Resource group to list subnets of",
+	}
+	fields["vnet"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Virtual network to list subnets of",
+	}
+	fields["names"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Names of subnets",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeAzureSubnetsRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeAzureSubnetsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeAzureCredentialsScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	p := azure.NewListAzureSubnetsParams().WithContext(ctx)
+	p.ResourceGroup = strToPtr(d.Get("resource_group").(string))
+	p.VirtualNetwork = strToPtr(d.Get("vnet").(string))
+	p.ClientID = scope.clientID
+	p.ClientSecret = scope.clientSecret
+	p.SubscriptionID = scope.subscriptionID
+	p.TenantID = scope.tenantID
+	r, err := k.client.Azure.ListAzureSubnets(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list azure subnets: %s", stringifyResponseError(err))
+	}
+
+	var names []string
+	if r.Payload != nil {
+		names = r.Payload.Subnets
+	}
+
+	d.SetId(scope.id() + ":" + d.Get("resource_group").(string) + ":" + d.Get("vnet").(string))
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package metakube
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// metakubeCloudScopeFields returns arguments shared by cloud provider data sources. Resources are listed either
+// for an existing cluster, using its credentials, or for a datacenter with the given credentials.
+// All credentials require dc_name, dc_name requires the required ones.
+func metakubeCloudScopeFields(cloud string, credentials map[string]*schema.Schema, required ...string) map[string]*schema.Schema {
+	ret := map[string]*schema.Schema{
+		"project_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"dc_name"},
+			Description:   "Project the cluster belongs to",
+		},
+		"cluster_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"cluster_id", "dc_name"},
+			Description:  fmt.Sprintf("Cluster to use %s credentials of", cloud),
+		},
+		"dc_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"cluster_id", "dc_name"},
+			RequiredWith: required,
+			Description:  fmt.Sprintf("Datacenter name, requires %s credentials", cloud),
+		},
+	}
+	for k, v := range credentials {
+		v.Optional = true
+		v.RequiredWith = []string{"dc_name"}
+		ret[k] = v
+	}
+	return ret
+}
+
+// metakubeCloudCredentialsFields returns arguments of data sources that can only be listed with explicit credentials.
+func metakubeCloudCredentialsFields(credentials map[string]*schema.Schema) map[string]*schema.Schema {
+	ret := map[string]*schema.Schema{
+		"dc_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Datacenter name",
+		},
+	}
+	for k, v := range credentials {
+		v.Required = true
+		ret[k] = v
+	}
+	return ret
+}
+
+// metakubeDataSourceProjectID returns configured project_id, or looks up the project owning cluster_id if unset.
+func metakubeDataSourceProjectID(ctx context.Context, d *schema.ResourceData, k *metakubeProviderMeta) (string, diag.Diagnostics) {
+	if projectID := d.Get("project_id").(string); projectID != "" {
+		return projectID, nil
+	}
+	clusterID := d.Get("cluster_id").(string)
+	projectID, err := metakubeResourceClusterFindProjectID(ctx, clusterID, k)
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	if projectID == "" {
+		return "", diag.Errorf("owner project for cluster '%s' is not found", clusterID)
+	}
+	return projectID, nil
+}
+
+// metakubeCloudScope holds cluster or datacenter cloud provider resources are listed for.
+type metakubeCloudScope struct {
+	projectID string
+	clusterID string
+	dcName    string
+}
+
+func (s *metakubeCloudScope) clusterScoped() bool {
+	return s.clusterID != ""
+}
+
+// metakubeCloudDataSourceScope returns scope of cluster_id if set, otherwise of dc_name. Credentials are left to the caller.
+func metakubeCloudDataSourceScope(ctx context.Context, d *schema.ResourceData, k *metakubeProviderMeta) (metakubeCloudScope, diag.Diagnostics) {
+	if clusterID := d.Get("cluster_id").(string); clusterID != "" {
+		projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+		if diags != nil {
+			return metakubeCloudScope{}, diags
+		}
+		_ = d.Set("project_id", projectID)
+		return metakubeCloudScope{projectID: projectID, clusterID: clusterID}, nil
+	}
+	return metakubeCloudScope{dcName: d.Get("dc_name").(string)}, nil
+}
+
+// listingID returns identifier of the listing for data source id, made of datacenter and given credentials
+// unless scope is a cluster.
+func (s *metakubeCloudScope) listingID(credentials ...*string) string {
+	if s.clusterScoped() {
+		return s.clusterID
+	}
+	ret := s.dcName
+	for _, v := range credentials {
+		if v != nil {
+			ret += ":" + *v
+		}
+	}
+	return ret
+}
@@ -0,0 +1,132 @@
+package metakube
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeCluster() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeClusterRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project the cluster belongs to",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster name",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Kubernetes version of the cluster",
+			},
+			"dc_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Data center name",
+			},
+			"cloud_provider": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cloud provider of the cluster, e.g. openstack or aws",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Labels of the cluster, including labels inherited from the project",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"creation_timestamp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp",
+			},
+			"kube_config": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Admin kubeconfig of the cluster",
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	p := project.NewListClustersV2Params().WithContext(ctx).WithProjectID(projectID)
+	r, err := k.client.Project.ListClustersV2(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list clusters of project '%s': %s", projectID, stringifyResponseError(err))
+	}
+
+	var cluster *models.Cluster
+	for _, c := range r.Payload {
+		if c == nil || c.Name != name || !time.Time(c.DeletionTimestamp).IsZero() {
+			continue
+		}
+		if cluster != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Ambiguous cluster name",
+				Detail:        "More than one cluster named '" + name + "' found in project '" + projectID + "'",
+				AttributePath: cty.GetAttrPath("name"),
+			}}
+		}
+		cluster = c
+	}
+	if cluster == nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Cluster not found",
+			Detail:        "No cluster named '" + name + "' found in project '" + projectID + "'",
+			AttributePath: cty.GetAttrPath("name"),
+		}}
+	}
+
+	d.SetId(cluster.ID)
+	_ = d.Set("creation_timestamp", cluster.CreationTimestamp.String())
+	if err := d.Set("labels", cluster.Labels); err != nil {
+		return diag.FromErr(err)
+	}
+	if cluster.Spec != nil {
+		if v, ok := cluster.Spec.Version.(string); ok {
+			_ = d.Set("version", v)
+		}
+		if cluster.Spec.Cloud != nil {
+			_ = d.Set("dc_name", cluster.Spec.Cloud.DatacenterName)
+			if provider, err := getClusterCloudProvider(cluster); err == nil {
+				_ = d.Set("cloud_provider", provider)
+			}
+		}
+	}
+
+	kubeConfigParams := project.NewGetClusterKubeconfigV2Params()
+	kubeConfigParams.SetContext(ctx)
+	kubeConfigParams.SetProjectID(projectID)
+	kubeConfigParams.SetClusterID(cluster.ID)
+	ret, err := k.client.Project.GetClusterKubeconfigV2(kubeConfigParams, k.auth)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       "Failed to get kube_config: " + stringifyResponseError(err),
+			AttributePath: cty.GetAttrPath("kube_config"),
+		}}
+	}
+	_ = d.Set("kube_config", string(ret.Payload))
+
+	return nil
+}
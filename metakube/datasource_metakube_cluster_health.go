@@ -0,0 +1,96 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+	"github.com/syseleven/go-metakube/models"
+)
+
+// metakubeClusterHealthComponents maps attribute names to control plane components reported by the API.
+var metakubeClusterHealthComponents = map[string]func(*models.ClusterHealth) models.HealthStatus{
+	"apiserver":                       func(h *models.ClusterHealth) models.HealthStatus { return h.Apiserver },
+	"scheduler":                       func(h *models.ClusterHealth) models.HealthStatus { return h.Scheduler },
+	"controller":                      func(h *models.ClusterHealth) models.HealthStatus { return h.Controller },
+	"etcd":                            func(h *models.ClusterHealth) models.HealthStatus { return h.Etcd },
+	"machine_controller":              func(h *models.ClusterHealth) models.HealthStatus { return h.MachineController },
+	"user_cluster_controller_manager": func(h *models.ClusterHealth) models.HealthStatus { return h.UserClusterControllerManager },
+	"cloud_provider_infrastructure":   func(h *models.ClusterHealth) models.HealthStatus { return h.CloudProviderInfrastructure },
+}
+
+func dataSourceMetakubeClusterHealth() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"project_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Project the cluster belongs to",
+		},
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Cluster to get health of",
+		},
+		"healthy": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether all control plane components are up",
+		},
+	}
+	for name := range metakubeClusterHealthComponents {
+		fields[name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Health of the component, one of up, down or provisioning",
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeClusterHealthRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeClusterHealthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	clusterID := d.Get("cluster_id").(string)
+	projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	p := project.NewGetClusterHealthV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(clusterID)
+	r, err := k.client.Project.GetClusterHealthV2(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to get cluster '%s' health: %s", clusterID, stringifyResponseError(err))
+	}
+
+	health := r.Payload
+	if health == nil {
+		health = &models.ClusterHealth{}
+	}
+	d.SetId(clusterID)
+	_ = d.Set("project_id", projectID)
+	_ = d.Set("healthy", metakubeClusterHealthy(r.Payload))
+	for name, status := range metakubeClusterHealthComponents {
+		_ = d.Set(name, metakubeHealthStatusString(status(health)))
+	}
+
+	return nil
+}
+
+func metakubeHealthStatusString(s models.HealthStatus) string {
+	switch s {
+	case 0:
+		return "down"
+	case 1:
+		return "up"
+	case 2:
+		return "provisioning"
+	default:
+		return "unknown"
+	}
+}
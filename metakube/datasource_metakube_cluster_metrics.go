@@ -0,0 +1,130 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+)
+
+func dataSourceMetakubeClusterMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeClusterMetricsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Project the cluster belongs to",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster to get metrics of",
+			},
+			"control_plane": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Resource usage of the control plane",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_total_millicores": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "CPU used by the control plane in millicores",
+						},
+						"memory_total_bytes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Memory used by the control plane in bytes",
+						},
+					},
+				},
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Aggregated resource usage of all nodes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_total_millicores": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total CPU of the nodes in millicores",
+						},
+						"cpu_available_millicores": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Available CPU of the nodes in millicores",
+						},
+						"cpu_used_percentage": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Used CPU in percent",
+						},
+						"memory_total_bytes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total memory of the nodes in bytes",
+						},
+						"memory_available_bytes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Available memory of the nodes in bytes",
+						},
+						"memory_used_percentage": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Used memory in percent",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeClusterMetricsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	clusterID := d.Get("cluster_id").(string)
+	projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	p := project.NewGetClusterMetricsV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(clusterID)
+	r, err := k.client.Project.GetClusterMetricsV2(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to get metrics of cluster '%s': %s", clusterID, stringifyResponseError(err))
+	}
+
+	d.SetId(clusterID)
+	_ = d.Set("project_id", projectID)
+	var controlPlane, nodes []interface{}
+	if cp := r.Payload.ControlPlane; cp != nil {
+		controlPlane = []interface{}{map[string]interface{}{
+			"cpu_total_millicores": cp.CPUTotalMillicores,
+			"memory_total_bytes":   cp.MemoryTotalBytes,
+		}}
+	}
+	if n := r.Payload.Nodes; n != nil {
+		nodes = []interface{}{map[string]interface{}{
+			"cpu_total_millicores":     n.CPUTotalMillicores,
+			"cpu_available_millicores": n.CPUAvailableMillicores,
+			"cpu_used_percentage":      n.CPUUsedPercentage,
+			"memory_total_bytes":       n.MemoryTotalBytes,
+			"memory_available_bytes":   n.MemoryAvailableBytes,
+			"memory_used_percentage":   n.MemoryUsedPercentage,
+		}}
+	}
+	if err := d.Set("control_plane", controlPlane); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/metric"
+	"github.com/syseleven/go-metakube/client/project"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeClusterMetricsNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeClusterMetricsNodesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Project the cluster belongs to",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster to collect node metrics for",
+			},
+			"node_deployment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only collect metrics of nodes of this node deployment",
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Resource usage of every node in the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node name",
+						},
+						"node_deployment_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node deployment the node belongs to",
+						},
+						"cpu_total_millicores": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total CPU of the node in millicores",
+						},
+						"cpu_available_millicores": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Available CPU of the node in millicores",
+						},
+						"cpu_used_percentage": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Used CPU in percent",
+						},
+						"memory_total_bytes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total memory of the node in bytes",
+						},
+						"memory_available_bytes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Available memory of the node in bytes",
+						},
+						"memory_used_percentage": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Used memory in percent",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeClusterMetricsNodesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	clusterID := d.Get("cluster_id").(string)
+	projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	id := clusterID
+	if v, ok := d.GetOk("node_deployment_id"); ok {
+		id += ":" + v.(string)
+	}
+	nodeDeploymentIDs, diags := metakubeDataSourceNodeDeploymentIDs(ctx, d, k, projectID, clusterID)
+	if diags != nil {
+		return diags
+	}
+
+	nodes := make([]interface{}, 0)
+	for _, ndeplID := range nodeDeploymentIDs {
+		mp := metric.NewListMachineDeploymentMetricsParams().
+			WithContext(ctx).
+			WithProjectID(projectID).
+			WithClusterID(clusterID).
+			WithMachineDeploymentID(ndeplID)
+		mr, err := k.client.Metric.ListMachineDeploymentMetrics(mp, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to get metrics of node deployment '%s': %s", ndeplID, stringifyResponseError(err))
+		}
+		nodes = append(nodes, metakubeFlattenNodeMetrics(ndeplID, mr.Payload)...)
+	}
+
+	d.SetId(id)
+	_ = d.Set("project_id", projectID)
+	if err := d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// metakubeDataSourceNodeDeploymentIDs returns configured node_deployment_id, or ids of all cluster node deployments if unset.
+func metakubeDataSourceNodeDeploymentIDs(ctx context.Context, d *schema.ResourceData, k *metakubeProviderMeta, projectID, clusterID string) ([]string, diag.Diagnostics) {
+	if v, ok := d.GetOk("node_deployment_id"); ok {
+		return []string{v.(string)}, nil
+	}
+	p := project.NewListMachineDeploymentsParams().
+		WithContext(ctx).
+		WithProjectID(projectID).
+		WithClusterID(clusterID)
+	r, err := k.client.Project.ListMachineDeployments(p, k.auth)
+	if err != nil {
+		return nil, diag.Errorf("unable to list node deployments of cluster '%s': %s", clusterID, stringifyResponseError(err))
+	}
+	var ret []string
+	for _, ndepl := range r.Payload {
+		if ndepl != nil {
+			ret = append(ret, ndepl.ID)
+		}
+	}
+	return ret, nil
+}
+
+func metakubeFlattenNodeMetrics(nodeDeploymentID string, in []*models.NodeMetric) []interface{} {
+	var ret []interface{}
+	for _, v := range in {
+		if v == nil {
+			continue
+		}
+		ret = append(ret, map[string]interface{}{
+			"name":                     v.Name,
+			"node_deployment_id":       nodeDeploymentID,
+			"cpu_total_millicores":     v.CPUTotalMillicores,
+			"cpu_available_millicores": v.CPUAvailableMillicores,
+			"cpu_used_percentage":      v.CPUUsedPercentage,
+			"memory_total_bytes":       v.MemoryTotalBytes,
+			"memory_available_bytes":   v.MemoryAvailableBytes,
+			"memory_used_percentage":   v.MemoryUsedPercentage,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,183 @@
+package metakube
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeClusterNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeClusterNodesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Project the cluster belongs to",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster to list nodes of",
+			},
+			"node_deployment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list nodes of this node deployment",
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Nodes of the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node identifier",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node name",
+						},
+						"node_deployment_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node deployment the node belongs to",
+						},
+						"machine_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the machine backing the node",
+						},
+						"internal_ips": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Internal IP addresses of the node",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"external_ips": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "External IP addresses of the node",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Hostname of the node",
+						},
+						"kubelet_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kubelet version of the node",
+						},
+						"operating_system": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Operating system of the node",
+						},
+						"error_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error provisioning the node, if any",
+						},
+						"creation_timestamp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation timestamp",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeClusterNodesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	clusterID := d.Get("cluster_id").(string)
+	projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	id := clusterID
+	if v, ok := d.GetOk("node_deployment_id"); ok {
+		id += ":" + v.(string)
+	}
+	nodeDeploymentIDs, diags := metakubeDataSourceNodeDeploymentIDs(ctx, d, k, projectID, clusterID)
+	if diags != nil {
+		return diags
+	}
+
+	nodes := make([]interface{}, 0)
+	for _, ndeplID := range nodeDeploymentIDs {
+		p := project.NewListMachineDeploymentNodesParams().
+			WithContext(ctx).
+			WithProjectID(projectID).
+			WithClusterID(clusterID).
+			WithMachineDeploymentID(ndeplID)
+		r, err := k.client.Project.ListMachineDeploymentNodes(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list nodes of node deployment '%s': %s", ndeplID, stringifyResponseError(err))
+		}
+		nodes = append(nodes, metakubeFlattenClusterNodes(ndeplID, r.Payload)...)
+	}
+
+	d.SetId(id)
+	_ = d.Set("project_id", projectID)
+	if err := d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func metakubeFlattenClusterNodes(nodeDeploymentID string, in []*models.Node) []interface{} {
+	var ret []interface{}
+	for _, v := range in {
+		if v == nil || !time.Time(v.DeletionTimestamp).IsZero() {
+			continue
+		}
+		att := map[string]interface{}{
+			"id":                 v.ID,
+			"name":               v.Name,
+			"node_deployment_id": nodeDeploymentID,
+			"creation_timestamp": v.CreationTimestamp.String(),
+		}
+		if s := v.Status; s != nil {
+			internal, external := make([]interface{}, 0), make([]interface{}, 0)
+			for _, a := range s.Addresses {
+				if a == nil {
+					continue
+				}
+				switch a.Type {
+				case "InternalIP":
+					internal = append(internal, a.Address)
+				case "ExternalIP":
+					external = append(external, a.Address)
+				case "Hostname":
+					att["hostname"] = a.Address
+				}
+			}
+			att["internal_ips"] = internal
+			att["external_ips"] = external
+			att["machine_name"] = s.MachineName
+			att["error_message"] = s.ErrorMessage
+			if s.NodeInfo != nil {
+				att["kubelet_version"] = s.NodeInfo.KubeletVersion
+				att["operating_system"] = s.NodeInfo.OperatingSystem
+			}
+		}
+		ret = append(ret, att)
+	}
+	return ret
+}
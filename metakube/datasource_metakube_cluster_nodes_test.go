@@ -0,0 +1,60 @@
+package metakube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func TestMetakubeFlattenClusterNodes(t *testing.T) {
+	created := strfmt.DateTime(time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC))
+	in := []*models.Node{
+		{
+			ID:                "node-a",
+			Name:              "node-a",
+			CreationTimestamp: created,
+			Status: &models.NodeStatus{
+				MachineName: "machine-a",
+				Addresses: []*models.NodeAddress{
+					{Type: "InternalIP", Address: "192.168.1.10"},
+					{Type: "ExternalIP", Address: "203.0.113.10"},
+					nil,
+					{Type: "Hostname", Address: "node-a"},
+					{Type: "InternalIP", Address: "fd00::10"},
+				},
+				NodeInfo: &models.NodeSystemInfo{KubeletVersion: "v1.21.3", OperatingSystem: "linux"},
+			},
+		},
+		nil,
+		{ID: "node-b", Name: "node-b", DeletionTimestamp: created},
+		{ID: "node-c", Name: "node-c", CreationTimestamp: created},
+	}
+
+	want := []interface{}{
+		map[string]interface{}{
+			"id":                 "node-a",
+			"name":               "node-a",
+			"node_deployment_id": "ndepl",
+			"creation_timestamp": created.String(),
+			"machine_name":       "machine-a",
+			"internal_ips":       []interface{}{"192.168.1.10", "fd00::10"},
+			"external_ips":       []interface{}{"203.0.113.10"},
+			"hostname":           "node-a",
+			"error_message":      "",
+			"kubelet_version":    "v1.21.3",
+			"operating_system":   "linux",
+		},
+		map[string]interface{}{
+			"id":                 "node-c",
+			"name":               "node-c",
+			"node_deployment_id": "ndepl",
+			"creation_timestamp": created.String(),
+		},
+	}
+	if diff := cmp.Diff(want, metakubeFlattenClusterNodes("ndepl", in)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,100 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/mod/semver"
+
+	"github.com/syseleven/go-metakube/client/project"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeClusterUpgrades() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeClusterUpgradesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Project the cluster belongs to",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster to get upgrades for",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Versions the cluster can be upgraded to, in ascending order",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"next": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lowest version the cluster can be upgraded to, empty if there is none",
+			},
+			"latest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The highest version the cluster can be upgraded to, empty if there is none",
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeClusterUpgradesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	clusterID := d.Get("cluster_id").(string)
+	projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	p := project.NewGetClusterUpgradesV2Params().
+		WithContext(ctx).
+		WithProjectID(projectID).
+		WithClusterID(clusterID)
+	r, err := k.client.Project.GetClusterUpgradesV2(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to get upgrades of cluster '%s': %s", clusterID, stringifyResponseError(err))
+	}
+
+	all := metakubeSortedVersions(r.Payload)
+	d.SetId(clusterID)
+	_ = d.Set("project_id", projectID)
+	if err := d.Set("versions", all); err != nil {
+		return diag.FromErr(err)
+	}
+	next, latest := "", ""
+	if len(all) > 0 {
+		next, latest = all[0], all[len(all)-1]
+	}
+	_ = d.Set("next", next)
+	_ = d.Set("latest", latest)
+
+	return nil
+}
+
+// metakubeSortedVersions returns versions in ascending order, skipping ones restricted by kubelet version.
+func metakubeSortedVersions(in []*models.MasterVersion) []string {
+	var tagged []string
+	for _, v := range in {
+		if v == nil || v.RestrictedByKubeletVersion {
+			continue
+		}
+		if s, ok := v.Version.(string); ok && semver.IsValid("v"+s) {
+			tagged = append(tagged, "v"+s)
+		}
+	}
+	semver.Sort(tagged)
+
+	ret := make([]string, len(tagged))
+	for i, v := range tagged {
+		ret[i] = v[1:]
+	}
+	return ret
+}
@@ -0,0 +1,23 @@
+package metakube
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func TestMetakubeSortedVersions(t *testing.T) {
+	in := []*models.MasterVersion{
+		{Version: "1.21.3"},
+		nil,
+		{Version: "1.20.10"},
+		{Version: "1.22.1", RestrictedByKubeletVersion: true},
+		{Version: "1.20.9"},
+		{Version: "not a version"},
+	}
+	want := []string{"1.20.9", "1.20.10", "1.21.3"}
+	if diff := cmp.Diff(want, metakubeSortedVersions(in)); diff != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,120 @@
+package metakube
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+)
+
+func dataSourceMetakubeClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeClustersRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project to list clusters of",
+			},
+			"clusters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Clusters of the project",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cluster identifier",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cluster name",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kubernetes version of the cluster",
+						},
+						"dc_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Data center name",
+						},
+						"cloud_provider": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud provider of the cluster",
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Labels of the cluster",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"healthy": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether all control plane components of the cluster are up",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeClustersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	projectID := d.Get("project_id").(string)
+
+	p := project.NewListClustersV2Params().WithContext(ctx).WithProjectID(projectID)
+	r, err := k.client.Project.ListClustersV2(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list clusters of project '%s': %s", projectID, stringifyResponseError(err))
+	}
+
+	clusters := make([]interface{}, 0, len(r.Payload))
+	for _, c := range r.Payload {
+		if c == nil || !time.Time(c.DeletionTimestamp).IsZero() {
+			continue
+		}
+		att := map[string]interface{}{
+			"id":     c.ID,
+			"name":   c.Name,
+			"labels": c.Labels,
+		}
+		if c.Spec != nil {
+			if v, ok := c.Spec.Version.(string); ok {
+				att["version"] = v
+			}
+			if c.Spec.Cloud != nil {
+				att["dc_name"] = c.Spec.Cloud.DatacenterName
+				if provider, err := getClusterCloudProvider(c); err == nil {
+					att["cloud_provider"] = provider
+				}
+			}
+		}
+
+		hp := project.NewGetClusterHealthV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(c.ID)
+		if hr, err := k.client.Project.GetClusterHealthV2(hp, k.auth); err != nil {
+			k.log.Debugf("unable to get cluster '%s' health: %s", c.ID, stringifyResponseError(err))
+			att["healthy"] = false
+		} else {
+			att["healthy"] = metakubeClusterHealthy(hr.Payload)
+		}
+
+		clusters = append(clusters, att)
+	}
+
+	d.SetId(projectID)
+	if err := d.Set("clusters", clusters); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
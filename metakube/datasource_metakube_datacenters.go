@@ -0,0 +1,157 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/datacenter"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeDatacenters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeDatacentersRead,
+		Schema: map[string]*schema.Schema{
+			"provider_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return datacenters of this cloud provider, e.g. openstack, aws or azure",
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return datacenters in this country, as ISO 3166-1 alpha-2 code",
+			},
+			"seed": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return datacenters served by this seed",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of datacenters matching the filters",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"datacenters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Datacenters matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Datacenter name, to be used as dc_name of clusters",
+						},
+						"provider_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud provider of the datacenter",
+						},
+						"country": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Country of the datacenter",
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human readable location of the datacenter",
+						},
+						"seed": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Seed serving the datacenter",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud provider region, or location for Azure",
+						},
+						"enforce_audit_logging": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether audit logging is enforced for clusters in the datacenter",
+						},
+						"enforce_pod_security_policy": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether pod security policy is enforced for clusters in the datacenter",
+						},
+						"enforce_floating_ip": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether OpenStack nodes in the datacenter always get a floating IP",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeDatacentersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+
+	r, err := k.client.Datacenter.ListDatacenters(datacenter.NewListDatacentersParams().WithContext(ctx), k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list datacenters: %s", stringifyResponseError(err))
+	}
+
+	filtered := metakubeFilterDatacenters(r.Payload, d.Get("provider_name").(string), d.Get("country").(string), d.Get("seed").(string))
+	names := make([]interface{}, 0, len(filtered))
+	datacenters := make([]interface{}, 0, len(filtered))
+	for _, dc := range filtered {
+		att := map[string]interface{}{
+			"name":                        dc.Metadata.Name,
+			"provider_name":               dc.Spec.Provider,
+			"country":                     dc.Spec.Country,
+			"location":                    dc.Spec.Location,
+			"seed":                        dc.Spec.Seed,
+			"enforce_audit_logging":       dc.Spec.EnforceAuditLogging,
+			"enforce_pod_security_policy": dc.Spec.EnforcePodSecurityPolicy,
+		}
+		switch {
+		case dc.Spec.Openstack != nil:
+			att["region"] = dc.Spec.Openstack.Region
+			att["enforce_floating_ip"] = dc.Spec.Openstack.EnforceFloatingIP
+		case dc.Spec.Aws != nil:
+			att["region"] = dc.Spec.Aws.Region
+		case dc.Spec.Azure != nil:
+			att["region"] = dc.Spec.Azure.Location
+		}
+		names = append(names, dc.Metadata.Name)
+		datacenters = append(datacenters, att)
+	}
+
+	// List depends on the token only, any stable value works as id.
+	d.SetId("datacenters")
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("datacenters", datacenters); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// metakubeFilterDatacenters returns datacenters clusters can be created in, seeds themselves are excluded.
+func metakubeFilterDatacenters(in []*models.Datacenter, provider, country, seed string) []*models.Datacenter {
+	var ret []*models.Datacenter
+	for _, dc := range in {
+		if dc == nil || dc.Metadata == nil || dc.Spec == nil || dc.Spec.Seed == "" {
+			continue
+		}
+		if (provider != "" && dc.Spec.Provider != provider) ||
+			(country != "" && dc.Spec.Country != country) ||
+			(seed != "" && dc.Spec.Seed != seed) {
+			continue
+		}
+		ret = append(ret, dc)
+	}
+	return ret
+}
@@ -0,0 +1,43 @@
+package metakube
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func TestMetakubeFilterDatacenters(t *testing.T) {
+	dc := func(name, provider, country, seed string) *models.Datacenter {
+		return &models.Datacenter{
+			Metadata: &models.DatacenterMeta{Name: name},
+			Spec:     &models.DatacenterSpec{Provider: provider, Country: country, Seed: seed},
+		}
+	}
+	dbl1 := dc("syseleven-dbl1", "openstack", "DE", "europe-west3")
+	cbk1 := dc("syseleven-cbk1", "openstack", "DE", "europe-west4")
+	aws := dc("aws-eu-central-1a", "aws", "DE", "europe-west3")
+	azure := dc("azure-westeurope", "azure", "NL", "europe-west3")
+	seed := dc("europe-west3", "", "DE", "")
+	all := []*models.Datacenter{dbl1, nil, cbk1, seed, aws, {Metadata: &models.DatacenterMeta{Name: "broken"}}, azure}
+
+	cases := []struct {
+		Provider string
+		Country  string
+		Seed     string
+		Expected []*models.Datacenter
+	}{
+		{"", "", "", []*models.Datacenter{dbl1, cbk1, aws, azure}},
+		{"openstack", "", "", []*models.Datacenter{dbl1, cbk1}},
+		{"", "NL", "", []*models.Datacenter{azure}},
+		{"", "", "europe-west3", []*models.Datacenter{dbl1, aws, azure}},
+		{"openstack", "DE", "europe-west4", []*models.Datacenter{cbk1}},
+		{"gcp", "", "", nil},
+	}
+	for i, tc := range cases {
+		got := metakubeFilterDatacenters(all, tc.Provider, tc.Country, tc.Seed)
+		if diff := cmp.Diff(tc.Expected, got); diff != "" {
+			t.Errorf("case %d: mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+}
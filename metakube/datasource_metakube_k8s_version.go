@@ -2,9 +2,12 @@ package metakube
 
 import (
 	"context"
-	"golang.org/x/mod/semver"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-version"
+	"golang.org/x/mod/semver"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -16,9 +19,10 @@ func dataSourceMetakubeK8sClusterVersion() *schema.Resource {
 		ReadContext: dataSourceMetakubeK8sClusterVersionRead,
 		Schema: map[string]*schema.Schema{
 			"major": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Kubernetes cluster major version",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"version_constraint"},
+				Description:   "Kubernetes cluster major version",
 			},
 			"minor": {
 				Type:         schema.TypeString,
@@ -26,6 +30,24 @@ func dataSourceMetakubeK8sClusterVersion() *schema.Resource {
 				RequiredWith: []string{"major"},
 				Description:  "Kubernetes cluster minor version",
 			},
+			"version_constraint": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"major"},
+				ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+					if _, err := version.NewConstraint(v.(string)); err != nil {
+						return nil, []error{fmt.Errorf("%s: %v", k, err)}
+					}
+					return nil, nil
+				},
+				Description: "Version constraint, e.g. \"~> 1.21.0\" or \">= 1.20, < 1.22\"",
+			},
+			"include_preview": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Consider pre-release versions",
+			},
 			"version": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -59,13 +81,15 @@ func dataSourceMetakubeK8sClusterVersionRead(ctx context.Context, d *schema.Reso
 		}
 	}
 
-	var available []string
-	for _, v := range all {
-		if strings.Index(v, partialVersionSpec) == 0 {
-			available = append(available, v)
+	var constraint version.Constraints
+	if v, ok := d.GetOk("version_constraint"); ok {
+		constraint, err = version.NewConstraint(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
+	available := metakubeFilterK8sVersions(all, partialVersionSpec, constraint, d.Get("include_preview").(bool))
 	if len(available) == 0 {
 		return diag.Errorf("found following versions but did not match specification: %s", strings.Join(all, " "))
 	}
@@ -82,3 +106,27 @@ func dataSourceMetakubeK8sClusterVersionRead(ctx context.Context, d *schema.Reso
 
 	return nil
 }
+
+// metakubeFilterK8sVersions returns versions that start with the given major or major.minor prefix
+// and satisfy the constraint. Pre-release versions are skipped unless includePreview is set.
+func metakubeFilterK8sVersions(all []string, prefix string, constraint version.Constraints, includePreview bool) []string {
+	var ret []string
+	for _, v := range all {
+		// Compare whole components, so that prefix 1.2 does not match 1.21.
+		if prefix != "" && !strings.HasPrefix(v+".", prefix+".") {
+			continue
+		}
+		parsed, err := version.NewVersion(v)
+		if err != nil {
+			continue
+		}
+		if parsed.Prerelease() != "" && !includePreview {
+			continue
+		}
+		if constraint != nil && !constraint.Check(parsed.Core()) {
+			continue
+		}
+		ret = append(ret, v)
+	}
+	return ret
+}
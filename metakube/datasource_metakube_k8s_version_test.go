@@ -0,0 +1,39 @@
+package metakube
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-version"
+)
+
+func TestMetakubeFilterK8sVersions(t *testing.T) {
+	all := []string{"1.2.9", "1.20.7", "1.21.1", "1.21.3", "1.22.0-rc.1", "1.22.0"}
+	mustConstraint := func(s string) version.Constraints {
+		c, err := version.NewConstraint(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	cases := []struct {
+		Prefix         string
+		Constraint     version.Constraints
+		IncludePreview bool
+		Expected       []string
+	}{
+		{"", nil, false, []string{"1.2.9", "1.20.7", "1.21.1", "1.21.3", "1.22.0"}},
+		{"1.2", nil, false, []string{"1.2.9"}},
+		{"1.21", nil, false, []string{"1.21.1", "1.21.3"}},
+		{"", mustConstraint("~> 1.21.0"), false, []string{"1.21.1", "1.21.3"}},
+		{"", mustConstraint(">= 1.22"), false, []string{"1.22.0"}},
+		{"", mustConstraint(">= 1.22"), true, []string{"1.22.0-rc.1", "1.22.0"}},
+		{"1.23", nil, true, nil},
+	}
+	for _, tc := range cases {
+		got := metakubeFilterK8sVersions(all, tc.Prefix, tc.Constraint, tc.IncludePreview)
+		if diff := cmp.Diff(tc.Expected, got); diff != "" {
+			t.Errorf("prefix %q, constraint %v: mismatch (-want +got):\n%s", tc.Prefix, tc.Constraint, diff)
+		}
+	}
+}
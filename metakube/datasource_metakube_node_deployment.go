@@ -0,0 +1,108 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeNodeDeployment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeNodeDeploymentRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Project the cluster belongs to",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster the node deployment belongs to",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Node deployment name",
+			},
+			"spec": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Node deployment specification",
+				Elem: &schema.Resource{
+					Schema: computedSchema(matakubeResourceNodeDeploymentSpecFields()),
+				},
+			},
+			"kubelet_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Kubelet version of the node deployment",
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Most recently observed status of the node deployment",
+				Elem: &schema.Resource{
+					Schema: metakubeResourceNodeDeploymentStatusFields(),
+				},
+			},
+			"creation_timestamp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp",
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	clusterID := d.Get("cluster_id").(string)
+	projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+	name := d.Get("name").(string)
+
+	p := project.NewListMachineDeploymentsParams().
+		WithContext(ctx).
+		WithProjectID(projectID).
+		WithClusterID(clusterID)
+	r, err := k.client.Project.ListMachineDeployments(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list node deployments of cluster '%s': %s", clusterID, stringifyResponseError(err))
+	}
+
+	var ndepl *models.NodeDeployment
+	for _, v := range r.Payload {
+		if v != nil && v.Name == name {
+			ndepl = v
+			break
+		}
+	}
+	if ndepl == nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Node deployment not found",
+			Detail:        "No node deployment named '" + name + "' found in cluster '" + clusterID + "'",
+			AttributePath: cty.GetAttrPath("name"),
+		}}
+	}
+
+	d.SetId(ndepl.ID)
+	_ = d.Set("project_id", projectID)
+	if err := d.Set("spec", metakubeNodeDeploymentFlattenSpec(ndepl.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("kubelet_version", metakubeNodeDeploymentKubeletVersion(ndepl.Spec))
+	_ = d.Set("status", metakubeNodeDeploymentFlattenStatus(ndepl.Status))
+	_ = d.Set("creation_timestamp", ndepl.CreationTimestamp.String())
+
+	return nil
+}
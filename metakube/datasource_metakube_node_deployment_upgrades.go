@@ -0,0 +1,94 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/versions"
+)
+
+func dataSourceMetakubeNodeDeploymentUpgrades() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeNodeDeploymentUpgradesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"control_plane_version"},
+				Description:   "Project the cluster belongs to",
+			},
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cluster_id", "control_plane_version"},
+				Description:  "Cluster to get allowed kubelet versions for",
+			},
+			"control_plane_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"cluster_id", "control_plane_version"},
+				Description:  "Control plane version to get allowed kubelet versions for",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Kubelet versions allowed with the control plane version, in ascending order",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"latest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The highest allowed kubelet version",
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeNodeDeploymentUpgradesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	controlPlaneVersion := d.Get("control_plane_version").(string)
+	if clusterID := d.Get("cluster_id").(string); clusterID != "" {
+		projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+		if diags != nil {
+			return diags
+		}
+		cluster, err := metakubeGetCluster(ctx, projectID, clusterID, k)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		v, ok := cluster.Spec.Version.(string)
+		if !ok || v == "" {
+			return diag.Errorf("unable to get version of cluster '%s'", clusterID)
+		}
+		controlPlaneVersion = v
+		_ = d.Set("project_id", projectID)
+	}
+
+	versionType := "kubernetes"
+	p := versions.NewGetNodeUpgradesParams().
+		WithContext(ctx).
+		WithType(&versionType).
+		WithControlPlaneVersion(&controlPlaneVersion)
+	r, err := k.client.Versions.GetNodeUpgrades(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to get node upgrades for control plane version '%s': %s", controlPlaneVersion, stringifyResponseError(err))
+	}
+
+	all := metakubeSortedVersions(r.Payload)
+	d.SetId(controlPlaneVersion)
+	_ = d.Set("control_plane_version", controlPlaneVersion)
+	if err := d.Set("versions", all); err != nil {
+		return diag.FromErr(err)
+	}
+	latest := ""
+	if len(all) > 0 {
+		latest = all[len(all)-1]
+	}
+	_ = d.Set("latest", latest)
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeNodeDeployments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeNodeDeploymentsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Project the cluster belongs to",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster to list node deployments of",
+			},
+			"node_deployments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Node deployments of the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node deployment identifier",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node deployment name",
+						},
+						"replicas": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Desired number of replicas",
+						},
+						"min_replicas": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum number of replicas autoscaler downscales to, 0 if autoscaler is not configured",
+						},
+						"max_replicas": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum number of replicas autoscaler upscales to, 0 if autoscaler is not configured",
+						},
+						"ready_replicas": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of ready nodes",
+						},
+						"kubelet_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kubelet version of the node deployment",
+						},
+						"paused": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether rollout of the node deployment is paused",
+						},
+						"healthy": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether all replicas of the node deployment are ready",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeNodeDeploymentsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	clusterID := d.Get("cluster_id").(string)
+	projectID, diags := metakubeDataSourceProjectID(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	p := project.NewListMachineDeploymentsParams().
+		WithContext(ctx).
+		WithProjectID(projectID).
+		WithClusterID(clusterID)
+	r, err := k.client.Project.ListMachineDeployments(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list node deployments of cluster '%s': %s", clusterID, stringifyResponseError(err))
+	}
+
+	d.SetId(clusterID)
+	_ = d.Set("project_id", projectID)
+	if err := d.Set("node_deployments", metakubeFlattenNodeDeploymentsSummary(r.Payload)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func metakubeFlattenNodeDeploymentsSummary(in []*models.NodeDeployment) []interface{} {
+	ret := make([]interface{}, 0, len(in))
+	for _, v := range in {
+		if v == nil || v.Spec == nil {
+			continue
+		}
+		var replicas int32
+		if v.Spec.Replicas != nil {
+			replicas = *v.Spec.Replicas
+		}
+		att := map[string]interface{}{
+			"id":              v.ID,
+			"name":            v.Name,
+			"replicas":        replicas,
+			"min_replicas":    v.Spec.MinReplicas,
+			"max_replicas":    v.Spec.MaxReplicas,
+			"kubelet_version": metakubeNodeDeploymentKubeletVersion(v.Spec),
+			"paused":          v.Spec.Paused,
+			"healthy":         metakubeNodeDeploymentReadyEnough(replicas, v.Status, 1),
+		}
+		if v.Status != nil {
+			att["ready_replicas"] = v.Status.ReadyReplicas
+		}
+		ret = append(ret, att)
+	}
+	return ret
+}
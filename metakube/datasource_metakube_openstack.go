@@ -0,0 +1,78 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// metakubeOpenstackScopeFields are arguments shared by OpenStack data sources. Project may be given by name or ID.
+func metakubeOpenstackScopeFields() map[string]*schema.Schema {
+	return metakubeCloudScopeFields("OpenStack", map[string]*schema.Schema{
+		"username": {
+			Type:        schema.TypeString,
+			Description: "OpenStack user name",
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Sensitive:   true,
+			Description: "OpenStack user password",
+		},
+		"domain": {
+			Type:        schema.TypeString,
+			Description: "OpenStack domain",
+		},
+		"tenant": {
+			Type:          schema.TypeString,
+			ConflictsWith: []string{"tenant_id"},
+			Description:   "OpenStack project name",
+		},
+		"tenant_id": {
+			Type:          schema.TypeString,
+			ConflictsWith: []string{"tenant"},
+			Description:   "OpenStack project ID",
+		},
+	}, "username", "password")
+}
+
+// metakubeOpenstackScope adds OpenStack credentials, set only for datacenter scope.
+type metakubeOpenstackScope struct {
+	metakubeCloudScope
+
+	username *string
+	password *string
+	domain   *string
+	tenant   *string
+	tenantID *string
+}
+
+func metakubeOpenstackDataSourceScope(ctx context.Context, d *schema.ResourceData, k *metakubeProviderMeta) (*metakubeOpenstackScope, diag.Diagnostics) {
+	scope, diags := metakubeCloudDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return nil, diags
+	}
+	if scope.clusterScoped() {
+		return &metakubeOpenstackScope{metakubeCloudScope: scope}, nil
+	}
+
+	optional := func(key string) *string {
+		if v, ok := d.GetOk(key); ok {
+			return strToPtr(v.(string))
+		}
+		return nil
+	}
+	return &metakubeOpenstackScope{
+		metakubeCloudScope: scope,
+		username:           optional("username"),
+		password:           optional("password"),
+		domain:             optional("domain"),
+		tenant:             optional("tenant"),
+		tenantID:           optional("tenant_id"),
+	}, nil
+}
+
+// id returns identifier of the listing for data source id.
+func (s *metakubeOpenstackScope) id() string {
+	return s.listingID(s.tenant, s.tenantID)
+}
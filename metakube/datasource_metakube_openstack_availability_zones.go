@@ -0,0 +1,74 @@
+package metakube
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/openstack"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeOpenstackAvailabilityZones() *schema.Resource {
+	fields := metakubeOpenstackScopeFields()
+	fields["names"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Names of compute availability zones",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeOpenstackAvailabilityZonesRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeOpenstackAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeOpenstackDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	var all []*models.OpenstackAvailabilityZone
+	if scope.clusterScoped() {
+		p := openstack.NewListOpenstackAvailabilityZonesNoCredentialsV2Params().WithContext(ctx).WithProjectID(scope.projectID).WithClusterID(scope.clusterID)
+		r, err := k.client.Openstack.ListOpenstackAvailabilityZonesNoCredentialsV2(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list openstack availability zones: %s", stringifyResponseError(err))
+		}
+		all = r.Payload
+	} else {
+		p := openstack.NewListOpenstackAvailabilityZonesParams().WithContext(ctx)
+		p.DatacenterName = strToPtr(scope.dcName)
+		p.Username = scope.username
+		p.Password = scope.password
+		p.Domain = scope.domain
+		p.Tenant = scope.tenant
+		p.TenantID = scope.tenantID
+		r, err := k.client.Openstack.ListOpenstackAvailabilityZones(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list openstack availability zones: %s", stringifyResponseError(err))
+		}
+		all = r.Payload
+	}
+
+	names := make([]string, 0, len(all))
+	for _, v := range all {
+		if v != nil && v.Name != "" {
+			names = append(names, v.Name)
+		}
+	}
+	// API order is not guaranteed, keep the list stable for for_each and count.
+	sort.Strings(names)
+
+	d.SetId(scope.id())
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
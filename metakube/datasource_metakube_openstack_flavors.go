@@ -0,0 +1,160 @@
+package metakube
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/syseleven/go-metakube/client/openstack"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeOpenstackFlavors() *schema.Resource {
+	fields := metakubeOpenstackScopeFields()
+	fields["name_regex"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+		Description:  "Only return flavors with names matching the regular expression",
+	}
+	fields["min_vcpus"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Only return flavors with at least this many virtual CPUs",
+	}
+	fields["min_memory_mb"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Only return flavors with at least this much memory in megabytes",
+	}
+	fields["min_disk_gb"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Only return flavors with at least this big root disk in gigabytes",
+	}
+	fields["flavors"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Flavors matching the filters",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Flavor name",
+				},
+				"vcpus": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of virtual CPUs",
+				},
+				"memory_mb": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Memory in megabytes",
+				},
+				"disk_gb": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Root disk size in gigabytes",
+				},
+				"is_public": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether flavor is available to all OpenStack projects",
+				},
+			},
+		},
+	}
+	fields["names"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Names of flavors matching the filters",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeOpenstackFlavorsRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeOpenstackFlavorsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeOpenstackDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	var sizes []*models.OpenstackSize
+	if scope.clusterScoped() {
+		p := openstack.NewListOpenstackSizesNoCredentialsV2Params().WithContext(ctx).WithProjectID(scope.projectID).WithClusterID(scope.clusterID)
+		r, err := k.client.Openstack.ListOpenstackSizesNoCredentialsV2(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list openstack flavors: %s", stringifyResponseError(err))
+		}
+		sizes = r.Payload
+	} else {
+		p := openstack.NewListOpenstackSizesParams().WithContext(ctx)
+		p.DatacenterName = strToPtr(scope.dcName)
+		p.Username = scope.username
+		p.Password = scope.password
+		p.Domain = scope.domain
+		p.Tenant = scope.tenant
+		p.TenantID = scope.tenantID
+		r, err := k.client.Openstack.ListOpenstackSizes(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list openstack flavors: %s", stringifyResponseError(err))
+		}
+		sizes = r.Payload
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	filtered := metakubeFilterOpenstackFlavors(sizes, nameRegex, int64(d.Get("min_vcpus").(int)), int64(d.Get("min_memory_mb").(int)), int64(d.Get("min_disk_gb").(int)))
+
+	flavors := make([]interface{}, 0, len(filtered))
+	names := make([]interface{}, 0, len(filtered))
+	for _, v := range filtered {
+		flavors = append(flavors, map[string]interface{}{
+			"name":      v.Slug,
+			"vcpus":     v.VCPUs,
+			"memory_mb": v.Memory,
+			"disk_gb":   v.Disk,
+			"is_public": v.IsPublic,
+		})
+		names = append(names, v.Slug)
+	}
+
+	d.SetId(scope.id())
+	if err := d.Set("flavors", flavors); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func metakubeFilterOpenstackFlavors(in []*models.OpenstackSize, nameRegex *regexp.Regexp, minVCPUs, minMemory, minDisk int64) []*models.OpenstackSize {
+	var ret []*models.OpenstackSize
+	for _, v := range in {
+		if v == nil || v.VCPUs < minVCPUs || v.Memory < minMemory || v.Disk < minDisk {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(v.Slug) {
+			continue
+		}
+		ret = append(ret, v)
+	}
+	return ret
+}
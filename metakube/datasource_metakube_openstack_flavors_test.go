@@ -0,0 +1,37 @@
+package metakube
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func TestMetakubeFilterOpenstackFlavors(t *testing.T) {
+	small := &models.OpenstackSize{Slug: "m1.small", VCPUs: 1, Memory: 2048, Disk: 20}
+	medium := &models.OpenstackSize{Slug: "m1.medium", VCPUs: 2, Memory: 4096, Disk: 40}
+	large := &models.OpenstackSize{Slug: "l1.large", VCPUs: 4, Memory: 8192, Disk: 80}
+	all := []*models.OpenstackSize{small, nil, medium, large}
+
+	cases := []struct {
+		NameRegex *regexp.Regexp
+		MinVCPUs  int64
+		MinMemory int64
+		MinDisk   int64
+		Expected  []*models.OpenstackSize
+	}{
+		{nil, 0, 0, 0, []*models.OpenstackSize{small, medium, large}},
+		{nil, 2, 0, 0, []*models.OpenstackSize{medium, large}},
+		{nil, 0, 8192, 0, []*models.OpenstackSize{large}},
+		{nil, 0, 0, 30, []*models.OpenstackSize{medium, large}},
+		{regexp.MustCompile(`^m1\.`), 2, 0, 0, []*models.OpenstackSize{medium}},
+		{nil, 8, 0, 0, nil},
+	}
+	for i, tc := range cases {
+		got := metakubeFilterOpenstackFlavors(all, tc.NameRegex, tc.MinVCPUs, tc.MinMemory, tc.MinDisk)
+		if diff := cmp.Diff(tc.Expected, got); diff != "" {
+			t.Errorf("case %d: mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMetakubeOpenstackFloatingIPPools() *schema.Resource {
+	fields := metakubeOpenstackScopeFields()
+	fields["names"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Names of floating IP pools",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	fields["ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "IDs of floating IP pools, in the same order as names",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeOpenstackFloatingIPPoolsRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeOpenstackFloatingIPPoolsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeOpenstackDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	all, err := metakubeListOpenstackNetworks(ctx, k, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Floating IPs are allocated from external networks.
+	var names, ids []interface{}
+	for _, v := range all {
+		if v != nil && v.External {
+			names = append(names, v.Name)
+			ids = append(ids, v.ID)
+		}
+	}
+
+	d.SetId(scope.id())
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
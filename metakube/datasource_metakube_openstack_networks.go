@@ -0,0 +1,114 @@
+package metakube
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/openstack"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeOpenstackNetworks() *schema.Resource {
+	fields := metakubeOpenstackScopeFields()
+	fields["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return networks with this name",
+	}
+	fields["external"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Only return external networks if true, only internal networks if false",
+	}
+	fields["networks"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Networks matching the filters",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Network ID",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Network name",
+				},
+				"external": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether network is external, e.g. a floating IP pool",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeOpenstackNetworksRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeOpenstackNetworksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeOpenstackDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	all, err := metakubeListOpenstackNetworks(ctx, k, scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	// GetOk can't tell false from unset.
+	external, filterExternal := d.GetOkExists("external")
+	networks := make([]interface{}, 0, len(all))
+	for _, v := range all {
+		if v == nil || (name != "" && v.Name != name) || (filterExternal && v.External != external.(bool)) {
+			continue
+		}
+		networks = append(networks, map[string]interface{}{
+			"id":       v.ID,
+			"name":     v.Name,
+			"external": v.External,
+		})
+	}
+
+	d.SetId(scope.id())
+	if err := d.Set("networks", networks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func metakubeListOpenstackNetworks(ctx context.Context, k *metakubeProviderMeta, scope *metakubeOpenstackScope) ([]*models.OpenstackNetwork, error) {
+	if scope.clusterScoped() {
+		p := openstack.NewListOpenstackNetworksNoCredentialsV2Params().WithContext(ctx).WithProjectID(scope.projectID).WithClusterID(scope.clusterID)
+		r, err := k.client.Openstack.ListOpenstackNetworksNoCredentialsV2(p, k.auth)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list openstack networks: %s", stringifyResponseError(err))
+		}
+		return r.Payload, nil
+	}
+
+	p := openstack.NewListOpenstackNetworksParams().WithContext(ctx)
+	p.DatacenterName = strToPtr(scope.dcName)
+	p.Username = scope.username
+	p.Password = scope.password
+	p.Domain = scope.domain
+	p.Tenant = scope.tenant
+	p.TenantID = scope.tenantID
+	r, err := k.client.Openstack.ListOpenstackNetworks(p, k.auth)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list openstack networks: %s", stringifyResponseError(err))
+	}
+	return r.Payload, nil
+}
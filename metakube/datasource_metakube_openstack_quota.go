@@ -0,0 +1,121 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/datacenter"
+	"github.com/syseleven/go-metakube/client/openstack"
+	"github.com/syseleven/go-metakube/client/project"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeOpenstackQuota() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"project_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Project the cluster belongs to",
+		},
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Cluster to use OpenStack credentials of",
+		},
+	}
+	for _, v := range []struct{ name, description string }{
+		{"cores", "virtual CPU cores"},
+		{"ram_mb", "RAM in megabytes"},
+		{"instances", "instances"},
+		{"floating_ips", "floating IPs"},
+	} {
+		fields["max_"+v.name] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Quota of " + v.description + ", -1 if unlimited",
+		}
+		fields["used_"+v.name] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of " + v.description + " in use",
+		}
+		fields["available_"+v.name] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of " + v.description + " still available, -1 if unlimited",
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeOpenstackQuotaRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeOpenstackQuotaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeOpenstackDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	// Quota endpoint is addressed by the seed of the cluster's datacenter.
+	cp := project.NewGetClusterV2Params().WithContext(ctx).WithProjectID(scope.projectID).WithClusterID(scope.clusterID)
+	cr, err := k.client.Project.GetClusterV2(cp, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to get cluster '%s/%s': %s", scope.projectID, scope.clusterID, stringifyResponseError(err))
+	}
+	if cr.Payload.Spec == nil || cr.Payload.Spec.Cloud == nil || cr.Payload.Spec.Cloud.Openstack == nil {
+		return diag.Errorf("cluster '%s' is not an OpenStack cluster", scope.clusterID)
+	}
+	dcName := cr.Payload.Spec.Cloud.DatacenterName
+	dr, err := k.client.Datacenter.GetDatacenter(datacenter.NewGetDatacenterParams().WithContext(ctx).WithDC(dcName), k.auth)
+	if err != nil {
+		return diag.Errorf("unable to get datacenter '%s': %s", dcName, stringifyResponseError(err))
+	}
+	if dr.Payload.Spec == nil || dr.Payload.Spec.Seed == "" {
+		return diag.Errorf("seed of datacenter '%s' is unknown", dcName)
+	}
+
+	p := openstack.NewListOpenstackQuotaLimitsNoCredentialsParams().
+		WithContext(ctx).
+		WithProjectID(scope.projectID).
+		WithDC(dr.Payload.Spec.Seed).
+		WithClusterID(scope.clusterID)
+	r, err := k.client.Openstack.ListOpenstackQuotaLimitsNoCredentials(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list openstack quota limits: %s", stringifyResponseError(err))
+	}
+
+	limits := &models.Absolute{}
+	if r.Payload.Limits != nil && r.Payload.Limits.Absolute != nil {
+		limits = r.Payload.Limits.Absolute
+	}
+	for name, v := range map[string][2]int64{
+		"cores":        {limits.MaxTotalCores, limits.TotalCoresUsed},
+		"ram_mb":       {limits.MaxTotalRAMSize, limits.TotalRAMUsed},
+		"instances":    {limits.MaxTotalInstances, limits.TotalInstancesUsed},
+		"floating_ips": {r.Payload.FloatingIPQuota, r.Payload.UsedFloatingIPCount},
+	} {
+		_ = d.Set("max_"+name, v[0])
+		_ = d.Set("used_"+name, v[1])
+		_ = d.Set("available_"+name, metakubeOpenstackQuotaAvailable(v[0], v[1]))
+	}
+	d.SetId(scope.clusterID)
+
+	return nil
+}
+
+// metakubeOpenstackQuotaAvailable returns what is left of the quota, OpenStack uses -1 for unlimited.
+func metakubeOpenstackQuotaAvailable(max, used int64) int64 {
+	if max < 0 {
+		return -1
+	}
+	if used > max {
+		return 0
+	}
+	return max - used
+}
@@ -0,0 +1,22 @@
+package metakube
+
+import "testing"
+
+func TestMetakubeOpenstackQuotaAvailable(t *testing.T) {
+	cases := []struct {
+		Max      int64
+		Used     int64
+		Expected int64
+	}{
+		{20, 5, 15},
+		{20, 20, 0},
+		{20, 25, 0},
+		{-1, 5, -1},
+		{0, 0, 0},
+	}
+	for i, tc := range cases {
+		if got := metakubeOpenstackQuotaAvailable(tc.Max, tc.Used); got != tc.Expected {
+			t.Errorf("case %d: want %d, got %d", i, tc.Expected, got)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/openstack"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeOpenstackSecurityGroups() *schema.Resource {
+	fields := metakubeOpenstackScopeFields()
+	fields["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return security groups with this name",
+	}
+	fields["security_groups"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Security groups matching the filters",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Security group ID",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Security group name",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeOpenstackSecurityGroupsRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeOpenstackSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeOpenstackDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+
+	var all []*models.OpenstackSecurityGroup
+	if scope.clusterScoped() {
+		p := openstack.NewListOpenstackSecurityGroupsNoCredentialsV2Params().WithContext(ctx).WithProjectID(scope.projectID).WithClusterID(scope.clusterID)
+		r, err := k.client.Openstack.ListOpenstackSecurityGroupsNoCredentialsV2(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list openstack security groups: %s", stringifyResponseError(err))
+		}
+		all = r.Payload
+	} else {
+		p := openstack.NewListOpenstackSecurityGroupsParams().WithContext(ctx)
+		p.DatacenterName = strToPtr(scope.dcName)
+		p.Username = scope.username
+		p.Password = scope.password
+		p.Domain = scope.domain
+		p.Tenant = scope.tenant
+		p.TenantID = scope.tenantID
+		r, err := k.client.Openstack.ListOpenstackSecurityGroups(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list openstack security groups: %s", stringifyResponseError(err))
+		}
+		all = r.Payload
+	}
+
+	name := d.Get("name").(string)
+	groups := make([]interface{}, 0, len(all))
+	for _, v := range all {
+		if v == nil || (name != "" && v.Name != name) {
+			continue
+		}
+		groups = append(groups, map[string]interface{}{
+			"id":   v.ID,
+			"name": v.Name,
+		})
+	}
+
+	d.SetId(scope.id())
+	if err := d.Set("security_groups", groups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/openstack"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeOpenstackSubnets() *schema.Resource {
+	fields := metakubeOpenstackScopeFields()
+	fields["network_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Network to list subnets of",
+	}
+	fields["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return subnets with this name",
+	}
+	fields["subnets"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Subnets of the network matching the filters",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Subnet ID",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Subnet name",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeOpenstackSubnetsRead,
+		Schema:      fields,
+	}
+}
+
+func dataSourceMetakubeOpenstackSubnetsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	scope, diags := metakubeOpenstackDataSourceScope(ctx, d, k)
+	if diags != nil {
+		return diags
+	}
+	networkID := d.Get("network_id").(string)
+
+	var all []*models.OpenstackSubnet
+	if scope.clusterScoped() {
+		p := openstack.NewListOpenstackSubnetsNoCredentialsV2Params().WithContext(ctx).WithProjectID(scope.projectID).WithClusterID(scope.clusterID).WithNetworkID(&networkID)
+		r, err := k.client.Openstack.ListOpenstackSubnetsNoCredentialsV2(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list subnets of openstack network '%s': %s", networkID, stringifyResponseError(err))
+		}
+		all = r.Payload
+	} else {
+		p := openstack.NewListOpenstackSubnetsParams().WithContext(ctx).WithNetworkID(&networkID)
+		p.DatacenterName = strToPtr(scope.dcName)
+		p.Username = scope.username
+		p.Password = scope.password
+		p.Domain = scope.domain
+		p.Tenant = scope.tenant
+		p.TenantID = scope.tenantID
+		r, err := k.client.Openstack.ListOpenstackSubnets(p, k.auth)
+		if err != nil {
+			return diag.Errorf("unable to list subnets of openstack network '%s': %s", networkID, stringifyResponseError(err))
+		}
+		all = r.Payload
+	}
+
+	name := d.Get("name").(string)
+	subnets := make([]interface{}, 0, len(all))
+	for _, v := range all {
+		if v == nil || (name != "" && v.Name != name) {
+			continue
+		}
+		subnets = append(subnets, map[string]interface{}{
+			"id":   v.ID,
+			"name": v.Name,
+		})
+	}
+
+	d.SetId(scope.id() + ":" + networkID)
+	if err := d.Set("subnets", subnets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
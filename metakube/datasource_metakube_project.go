@@ -0,0 +1,127 @@
+package metakube
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeProject() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeProjectRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project name",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Project identifier",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Project labels",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Project status",
+			},
+			"clusters_number": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of clusters in the project",
+			},
+			"owners": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Emails of project owners",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"creation_timestamp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp",
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	name := d.Get("name").(string)
+
+	r, err := k.client.Project.ListProjects(project.NewListProjectsParams().WithContext(ctx), k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list projects: %s", stringifyResponseError(err))
+	}
+
+	var prj *models.Project
+	for _, v := range r.Payload {
+		if v == nil || v.Name != name || !time.Time(v.DeletionTimestamp).IsZero() {
+			continue
+		}
+		if prj != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Ambiguous project name",
+				Detail:        "More than one project named '" + name + "' found",
+				AttributePath: cty.GetAttrPath("name"),
+			}}
+		}
+		prj = v
+	}
+	if prj == nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Project not found",
+			Detail:        "No project named '" + name + "' is visible to the current token",
+			AttributePath: cty.GetAttrPath("name"),
+		}}
+	}
+
+	d.SetId(prj.ID)
+	_ = d.Set("project_id", prj.ID)
+	if err := d.Set("labels", metakubeProjectVisibleLabels(prj.Labels)); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("status", prj.Status)
+	_ = d.Set("clusters_number", prj.ClustersNumber)
+	if err := d.Set("owners", metakubeFlattenProjectOwners(prj.Owners)); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("creation_timestamp", prj.CreationTimestamp.String())
+
+	return nil
+}
+
+// metakubeProjectVisibleLabels excludes labels used internally by the provider.
+func metakubeProjectVisibleLabels(in map[string]string) map[string]string {
+	ret := make(map[string]string, len(in))
+	for k, v := range in {
+		if k != projectEnsureFlawlessCreateUUIDLabelName {
+			ret[k] = v
+		}
+	}
+	return ret
+}
+
+func metakubeFlattenProjectOwners(in []*models.User) []interface{} {
+	ret := make([]interface{}, 0, len(in))
+	for _, v := range in {
+		if v != nil {
+			ret = append(ret, v.Email)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,126 @@
+package metakube
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeProjectMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeProjectMembersRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project to list members of",
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(metakubeProjectRoles, false),
+				Description:  "Only return members with this role in the project",
+			},
+			"emails": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Sorted emails of members matching the filter",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Members of the project sorted by email",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User's email address",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User's name",
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User's role in the project",
+						},
+						"creation_timestamp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the user joined MetaKube",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeProjectMembersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	projectID := d.Get("project_id").(string)
+
+	projectUsers, err := metakubeProjectUsers(ctx, k, projectID)
+	if err != nil {
+		return diag.Errorf("unable to list members of project '%s': %s", projectID, err)
+	}
+
+	members := metakubeFlattenProjectMembers(projectID, projectUsers, d.Get("group").(string))
+	emails := make([]interface{}, 0, len(members))
+	for _, v := range members {
+		emails = append(emails, v.(map[string]interface{})["email"])
+	}
+
+	d.SetId(projectID)
+	if err := d.Set("emails", emails); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func metakubeFlattenProjectMembers(projectID string, in map[string]models.User, group string) []interface{} {
+	emails := make([]string, 0, len(in))
+	for email := range in {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	ret := make([]interface{}, 0, len(emails))
+	for _, email := range emails {
+		u := in[email]
+		if !time.Time(u.DeletionTimestamp).IsZero() {
+			continue
+		}
+		userGroup := ""
+		for _, p := range u.Projects {
+			if p != nil && p.ID == projectID {
+				userGroup = p.GroupPrefix
+				break
+			}
+		}
+		if group != "" && userGroup != group {
+			continue
+		}
+		ret = append(ret, map[string]interface{}{
+			"email":              u.Email,
+			"name":               u.Name,
+			"group":              userGroup,
+			"creation_timestamp": u.CreationTimestamp.String(),
+		})
+	}
+	return ret
+}
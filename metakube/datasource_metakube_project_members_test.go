@@ -0,0 +1,49 @@
+package metakube
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func TestMetakubeFlattenProjectMembers(t *testing.T) {
+	users := map[string]models.User{
+		"bob@example.com": {
+			Email: "bob@example.com",
+			Name:  "Bob",
+			Projects: []*models.ProjectGroup{
+				{ID: "other", GroupPrefix: "owners"},
+				{ID: "prj", GroupPrefix: "viewers"},
+			},
+		},
+		"alice@example.com": {
+			Email:    "alice@example.com",
+			Name:     "Alice",
+			Projects: []*models.ProjectGroup{{ID: "prj", GroupPrefix: "owners"}},
+		},
+	}
+	member := func(u models.User, group string) map[string]interface{} {
+		return map[string]interface{}{
+			"email":              u.Email,
+			"name":               u.Name,
+			"group":              group,
+			"creation_timestamp": u.CreationTimestamp.String(),
+		}
+	}
+
+	cases := []struct {
+		Group    string
+		Expected []interface{}
+	}{
+		{"", []interface{}{member(users["alice@example.com"], "owners"), member(users["bob@example.com"], "viewers")}},
+		{"viewers", []interface{}{member(users["bob@example.com"], "viewers")}},
+		{"editors", []interface{}{}},
+	}
+	for i, tc := range cases {
+		got := metakubeFlattenProjectMembers("prj", users, tc.Group)
+		if diff := cmp.Diff(tc.Expected, got); diff != "" {
+			t.Errorf("case %d: mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package metakube
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+)
+
+func dataSourceMetakubeProjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeProjectsRead,
+		Schema: map[string]*schema.Schema{
+			"projects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Projects visible to the current token",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Project identifier",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Project name",
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Project labels",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Project status",
+						},
+						"owners": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Emails of project owners",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeProjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+
+	r, err := k.client.Project.ListProjects(project.NewListProjectsParams().WithContext(ctx), k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list projects: %s", stringifyResponseError(err))
+	}
+
+	projects := make([]interface{}, 0, len(r.Payload))
+	for _, v := range r.Payload {
+		if v == nil || !time.Time(v.DeletionTimestamp).IsZero() {
+			continue
+		}
+		projects = append(projects, map[string]interface{}{
+			"id":     v.ID,
+			"name":   v.Name,
+			"labels": metakubeProjectVisibleLabels(v.Labels),
+			"status": v.Status,
+			"owners": metakubeFlattenProjectOwners(v.Owners),
+		})
+	}
+
+	// List depends on the token only, any stable value works as id.
+	d.SetId("projects")
+	if err := d.Set("projects", projects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+	"github.com/syseleven/go-metakube/models"
+)
+
+func dataSourceMetakubeSSHKey() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeSSHKeyRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project the SSH key belongs to",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "SSH key name",
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SSH key fingerprint",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public part of the SSH key",
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeSSHKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	p := project.NewListSSHKeysParams().WithContext(ctx).WithProjectID(projectID)
+	r, err := k.client.Project.ListSSHKeys(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list SSH keys of project '%s': %s", projectID, stringifyResponseError(err))
+	}
+
+	var key *models.SSHKey
+	for _, v := range r.Payload {
+		if v == nil || v.Name != name {
+			continue
+		}
+		if key != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Ambiguous SSH key name",
+				Detail:        "More than one SSH key named '" + name + "' found in project '" + projectID + "'",
+				AttributePath: cty.GetAttrPath("name"),
+			}}
+		}
+		key = v
+	}
+	if key == nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "SSH key not found",
+			Detail:        "No SSH key named '" + name + "' found in project '" + projectID + "'",
+			AttributePath: cty.GetAttrPath("name"),
+		}}
+	}
+
+	d.SetId(key.ID)
+	if key.Spec != nil {
+		_ = d.Set("fingerprint", key.Spec.Fingerprint)
+		_ = d.Set("public_key", key.Spec.PublicKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package metakube
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/syseleven/go-metakube/client/project"
+)
+
+func dataSourceMetakubeSSHKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeSSHKeysRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project to list SSH keys of",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Identifiers of all SSH keys of the project",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sshkeys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "SSH keys of the project",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SSH key identifier",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SSH key name",
+						},
+						"fingerprint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SSH key fingerprint",
+						},
+						"public_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Public part of the SSH key",
+						},
+						"creation_timestamp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation timestamp",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeSSHKeysRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	projectID := d.Get("project_id").(string)
+
+	p := project.NewListSSHKeysParams().WithContext(ctx).WithProjectID(projectID)
+	r, err := k.client.Project.ListSSHKeys(p, k.auth)
+	if err != nil {
+		return diag.Errorf("unable to list SSH keys of project '%s': %s", projectID, stringifyResponseError(err))
+	}
+
+	ids := make([]interface{}, 0, len(r.Payload))
+	keys := make([]interface{}, 0, len(r.Payload))
+	for _, v := range r.Payload {
+		if v == nil {
+			continue
+		}
+		att := map[string]interface{}{
+			"id":                 v.ID,
+			"name":               v.Name,
+			"creation_timestamp": v.CreationTimestamp.String(),
+		}
+		if v.Spec != nil {
+			att["fingerprint"] = v.Spec.Fingerprint
+			att["public_key"] = v.Spec.PublicKey
+		}
+		ids = append(ids, v.ID)
+		keys = append(keys, att)
+	}
+
+	d.SetId(projectID)
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sshkeys", keys); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
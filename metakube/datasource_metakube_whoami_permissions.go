@@ -0,0 +1,147 @@
+package metakube
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/syseleven/go-metakube/models"
+)
+
+// metakubeProjectRoles lists project roles from the most to the least privileged.
+var metakubeProjectRoles = []string{"owners", "editors", "viewers"}
+
+func dataSourceMetakubeWhoamiPermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMetakubeWhoamiPermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only report permissions in this project",
+			},
+			"required_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"project_id"},
+				ValidateFunc: validation.StringInSlice(metakubeProjectRoles, false),
+				Description:  "Fail if the current token does not have at least this role in the project",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current user ID",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current user name",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current user email",
+			},
+			"is_admin": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether current user is MetaKube administrator",
+			},
+			"projects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Projects the current user is member of",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Project ID",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Role of the current user in the project",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetakubeWhoamiPermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	k := m.(*metakubeProviderMeta)
+	user, err := metakubeProjectCurrentUser(ctx, k)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	projects := make([]interface{}, 0)
+	role := ""
+	for _, v := range user.Projects {
+		if v == nil || (projectID != "" && v.ID != projectID) {
+			continue
+		}
+		if v.ID == projectID {
+			role = v.GroupPrefix
+		}
+		projects = append(projects, map[string]interface{}{
+			"project_id": v.ID,
+			"role":       v.GroupPrefix,
+		})
+	}
+
+	if required := d.Get("required_role").(string); required != "" && !user.IsAdmin && !metakubeProjectRoleSatisfies(role, required) {
+		if role == "" {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Insufficient permissions",
+				Detail:        fmt.Sprintf("Current token is not a member of project '%s', role '%s' is required", projectID, required),
+				AttributePath: cty.GetAttrPath("required_role"),
+			}}
+		}
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Insufficient permissions",
+			Detail:        fmt.Sprintf("Current token has role '%s' in project '%s', role '%s' is required", role, projectID, required),
+			AttributePath: cty.GetAttrPath("required_role"),
+		}}
+	}
+
+	d.SetId(metakubeWhoamiID(user, projectID))
+	_ = d.Set("user_id", user.ID)
+	_ = d.Set("name", user.Name)
+	_ = d.Set("email", user.Email)
+	_ = d.Set("is_admin", user.IsAdmin)
+	if err := d.Set("projects", projects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func metakubeWhoamiID(user *models.User, projectID string) string {
+	if projectID == "" {
+		return user.ID
+	}
+	return user.ID + ":" + projectID
+}
+
+// metakubeProjectRoleSatisfies returns true if role grants at least permissions of required role.
+func metakubeProjectRoleSatisfies(role, required string) bool {
+	for _, v := range metakubeProjectRoles {
+		if v == role {
+			return true
+		}
+		if v == required {
+			return false
+		}
+	}
+	return false
+}
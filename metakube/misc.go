@@ -3,7 +3,9 @@ package metakube
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/syseleven/go-metakube/models"
 )
 
@@ -47,3 +49,67 @@ func int64ToPtr(v int) *int64 {
 	vv := int64(v)
 	return &vv
 }
+
+// toJSONObject converts v to generic representation of its JSON object.
+func toJSONObject(v interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// jsonMergePatch returns RFC 7386 merge patch with only keys that differ between before and after.
+// Removed keys are set to nil, lists are replaced as a whole.
+func jsonMergePatch(before, after map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{})
+	for k, bv := range before {
+		av, ok := after[k]
+		if !ok {
+			patch[k] = nil
+			continue
+		}
+		bm, ok1 := bv.(map[string]interface{})
+		am, ok2 := av.(map[string]interface{})
+		if ok1 && ok2 {
+			if p := jsonMergePatch(bm, am); len(p) > 0 {
+				patch[k] = p
+			}
+			continue
+		}
+		if !reflect.DeepEqual(bv, av) {
+			patch[k] = av
+		}
+	}
+	for k, av := range after {
+		if _, ok := before[k]; !ok {
+			patch[k] = av
+		}
+	}
+	return patch
+}
+
+// computedSchema returns copy of resource schema with all fields computed, to reuse it in data sources.
+func computedSchema(in map[string]*schema.Schema) map[string]*schema.Schema {
+	ret := make(map[string]*schema.Schema, len(in))
+	for k, v := range in {
+		s := &schema.Schema{
+			Type:        v.Type,
+			Computed:    true,
+			Sensitive:   v.Sensitive,
+			Description: v.Description,
+		}
+		switch elem := v.Elem.(type) {
+		case *schema.Resource:
+			s.Elem = &schema.Resource{Schema: computedSchema(elem.Schema)}
+		case *schema.Schema:
+			s.Elem = &schema.Schema{Type: elem.Type}
+		}
+		ret[k] = s
+	}
+	return ret
+}
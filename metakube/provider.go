@@ -26,9 +26,16 @@ const (
 )
 
 type metakubeProviderMeta struct {
-	client *k8client.MetaKubeAPI
-	auth   runtime.ClientAuthInfoWriter
-	log    *zap.SugaredLogger
+	client    *k8client.MetaKubeAPI
+	auth      runtime.ClientAuthInfoWriter
+	log       *zap.SugaredLogger
+	userAgent string
+}
+
+// metakubeProviderModuleMeta is configured by modules in `provider_meta "metakube"` block.
+type metakubeProviderModuleMeta struct {
+	ModuleName    *string `cty:"module_name"`
+	ModuleVersion *string `cty:"module_version"`
 }
 
 // Provider returns a schema.Provider for MetaKube.
@@ -86,8 +93,57 @@ func Provider() *schema.Provider {
 			"metakube_service_account_token": metakubeResourceServiceAccountToken(),
 		},
 
+		ProviderMetaSchema: map[string]*schema.Schema{
+			"module_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the module using the provider, reported to MetaKube API",
+			},
+			"module_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Version of the module using the provider, reported to MetaKube API",
+			},
+		},
+
 		DataSourcesMap: map[string]*schema.Resource{
-			"metakube_k8s_version": dataSourceMetakubeK8sClusterVersion(),
+			"metakube_k8s_version":                  dataSourceMetakubeK8sClusterVersion(),
+			"metakube_cluster_metrics":              dataSourceMetakubeClusterMetrics(),
+			"metakube_cluster_metrics_nodes":        dataSourceMetakubeClusterMetricsNodes(),
+			"metakube_cluster_nodes":                dataSourceMetakubeClusterNodes(),
+			"metakube_addons":                       dataSourceMetakubeAddons(),
+			"metakube_addon_configs":                dataSourceMetakubeAddonConfigs(),
+			"metakube_cluster_upgrades":             dataSourceMetakubeClusterUpgrades(),
+			"metakube_whoami_permissions":           dataSourceMetakubeWhoamiPermissions(),
+			"metakube_cluster":                      dataSourceMetakubeCluster(),
+			"metakube_cluster_health":               dataSourceMetakubeClusterHealth(),
+			"metakube_clusters":                     dataSourceMetakubeClusters(),
+			"metakube_project":                      dataSourceMetakubeProject(),
+			"metakube_projects":                     dataSourceMetakubeProjects(),
+			"metakube_project_members":              dataSourceMetakubeProjectMembers(),
+			"metakube_node_deployment":              dataSourceMetakubeNodeDeployment(),
+			"metakube_node_deployments":             dataSourceMetakubeNodeDeployments(),
+			"metakube_node_deployment_upgrades":     dataSourceMetakubeNodeDeploymentUpgrades(),
+			"metakube_sshkey":                       dataSourceMetakubeSSHKey(),
+			"metakube_sshkeys":                      dataSourceMetakubeSSHKeys(),
+			"metakube_openstack_flavors":            dataSourceMetakubeOpenstackFlavors(),
+			"metakube_openstack_networks":           dataSourceMetakubeOpenstackNetworks(),
+			"metakube_openstack_subnets":            dataSourceMetakubeOpenstackSubnets(),
+			"metakube_openstack_security_groups":    dataSourceMetakubeOpenstackSecurityGroups(),
+			"metakube_openstack_floating_ip_pools":  dataSourceMetakubeOpenstackFloatingIPPools(),
+			"metakube_openstack_availability_zones": dataSourceMetakubeOpenstackAvailabilityZones(),
+			"metakube_openstack_quota":              dataSourceMetakubeOpenstackQuota(),
+			"metakube_aws_vpcs":                     dataSourceMetakubeAWSVPCs(),
+			"metakube_aws_subnets":                  dataSourceMetakubeAWSSubnets(),
+			"metakube_aws_security_groups":          dataSourceMetakubeAWSSecurityGroups(),
+			"metakube_azure_sizes":                  dataSourceMetakubeAzureSizes(),
+			"metakube_azure_resource_groups":        dataSourceMetakubeAzureResourceGroups(),
+			"metakube_azure_vnets":                  dataSourceMetakubeAzureVnets(),
+			"metakube_azure_subnets":                dataSourceMetakubeAzureSubnets(),
+			"metakube_azure_route_tables":           dataSourceMetakubeAzureRouteTables(),
+			"metakube_azure_security_groups":        dataSourceMetakubeAzureSecurityGroups(),
+			"metakube_azure_availability_zones":     dataSourceMetakubeAzureAvailabilityZones(),
+			"metakube_datacenters":                  dataSourceMetakubeDatacenters(),
 		},
 	}
 
@@ -119,7 +175,8 @@ func configure(d *schema.ResourceData, terraformVersion string, fd *os.File) (in
 	k.client, tmp = newClient(d.Get("host").(string))
 	diagnostics = append(diagnostics, tmp...)
 
-	k.auth, tmp = newAuth(d.Get("token").(string), d.Get("token_path").(string), terraformVersion)
+	k.userAgent = fmt.Sprintf("Terraform/%s", terraformVersion)
+	k.auth, tmp = newAuth(d.Get("token").(string), d.Get("token_path").(string), k.userAgent)
 	diagnostics = append(diagnostics, tmp...)
 
 	return &k, diagnostics
@@ -188,7 +245,7 @@ func newClient(host string) (*k8client.MetaKubeAPI, diag.Diagnostics) {
 	}), nil
 }
 
-func newAuth(token, tokenPath, terraformVersion string) (runtime.ClientAuthInfoWriter, diag.Diagnostics) {
+func newAuth(token, tokenPath, userAgent string) (runtime.ClientAuthInfoWriter, diag.Diagnostics) {
 	if token == "" && tokenPath != "" {
 		p, err := homedir.Expand(tokenPath)
 		if err != nil {
@@ -220,7 +277,42 @@ func newAuth(token, tokenPath, terraformVersion string) (runtime.ClientAuthInfoW
 		if err != nil {
 			return err
 		}
-		return r.SetHeaderParam("User-Agent", fmt.Sprintf("Terraform/%s", terraformVersion))
+		return r.SetHeaderParam("User-Agent", userAgent)
 	})
 	return auth, nil
 }
+
+// withModuleAttribution returns copy of provider meta which reports module set in provider_meta block
+// as a part of User-Agent, so it is visible which modules created resources.
+func (k *metakubeProviderMeta) withModuleAttribution(d *schema.ResourceData) *metakubeProviderMeta {
+	var moduleMeta metakubeProviderModuleMeta
+	if err := d.GetProviderMeta(&moduleMeta); err != nil {
+		k.log.Debugf("read provider_meta: %v", err)
+		return k
+	}
+	userAgent := metakubeModuleUserAgent(k.userAgent, moduleMeta)
+	if userAgent == k.userAgent {
+		return k
+	}
+
+	ret := *k
+	ret.userAgent = userAgent
+	ret.auth = runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		if err := k.auth.AuthenticateRequest(r, reg); err != nil {
+			return err
+		}
+		return r.SetHeaderParam("User-Agent", userAgent)
+	})
+	return &ret
+}
+
+func metakubeModuleUserAgent(userAgent string, moduleMeta metakubeProviderModuleMeta) string {
+	if moduleMeta.ModuleName == nil || *moduleMeta.ModuleName == "" {
+		return userAgent
+	}
+	module := *moduleMeta.ModuleName
+	if moduleMeta.ModuleVersion != nil && *moduleMeta.ModuleVersion != "" {
+		module += "/" + *moduleMeta.ModuleVersion
+	}
+	return fmt.Sprintf("%s terraform-module/%s", userAgent, module)
+}
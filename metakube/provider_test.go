@@ -128,3 +128,34 @@ func testResourceInstanceState(name string, check func(*terraform.InstanceState)
 
 	}
 }
+
+func TestMetakubeModuleUserAgent(t *testing.T) {
+	name, version, empty := "example-module", "1.2.0", ""
+	cases := []struct {
+		Input          metakubeProviderModuleMeta
+		ExpectedOutput string
+	}{
+		{
+			metakubeProviderModuleMeta{},
+			"Terraform/1.0.0",
+		},
+		{
+			metakubeProviderModuleMeta{ModuleName: &empty, ModuleVersion: &version},
+			"Terraform/1.0.0",
+		},
+		{
+			metakubeProviderModuleMeta{ModuleName: &name},
+			"Terraform/1.0.0 terraform-module/example-module",
+		},
+		{
+			metakubeProviderModuleMeta{ModuleName: &name, ModuleVersion: &version},
+			"Terraform/1.0.0 terraform-module/example-module/1.2.0",
+		},
+	}
+
+	for _, tc := range cases {
+		if output := metakubeModuleUserAgent("Terraform/1.0.0", tc.Input); output != tc.ExpectedOutput {
+			t.Fatalf("unexpected user agent: want %q, got %q", tc.ExpectedOutput, output)
+		}
+	}
+}
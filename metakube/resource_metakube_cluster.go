@@ -4,8 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -18,6 +23,10 @@ import (
 	"github.com/syseleven/go-metakube/models"
 )
 
+const (
+	clusterCreateUUIDLabelName = "terraform-provider-metakube/create-request"
+)
+
 func metakubeResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: metakubeResourceClusterCreate,
@@ -28,6 +37,12 @@ func metakubeResourceCluster() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:        schema.TypeString,
@@ -42,9 +57,20 @@ func metakubeResourceCluster() *schema.Resource {
 				Description: "Data center name",
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Cluster name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "name_prefix"},
+				Description:  "Cluster name",
+			},
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"name", "name_prefix"},
+				// Generated suffix is 26 characters long, keep names within 63 characters.
+				ValidateFunc: validation.StringLenBetween(1, 37),
+				Description:  "Creates a unique cluster name beginning with the specified prefix",
 			},
 			"labels": {
 				Type:        schema.TypeMap,
@@ -52,9 +78,20 @@ func metakubeResourceCluster() *schema.Resource {
 				Description: "Labels added to cluster",
 			},
 			"sshkeys": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "SSH keys attached to nodes",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"auto_attach_ssh_keys"},
+				Description:   "SSH keys attached to nodes",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"auto_attach_ssh_keys": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"sshkeys"},
+				Description:   "Names of project SSH keys attached to nodes",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.NoZeroValues,
@@ -107,14 +144,18 @@ func metakubeResourceClusterIsVersionDowngraded(_ context.Context, old, new, met
 }
 
 func metakubeResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diagnostics diag.Diagnostics) {
-	meta := m.(*metakubeProviderMeta)
+	meta := m.(*metakubeProviderMeta).withModuleAttribution(d)
 	retDiags := metakubeResourceClusterValidateClusterFields(ctx, d, meta)
 	spec := d.Get("spec").([]interface{})
 	dcname := d.Get("dc_name").(string)
 	clusterSpec := metakubeResourceClusterExpandSpec(spec, dcname)
+	name := d.Get("name").(string)
+	if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	}
 	createClusterSpec := &models.CreateClusterSpec{
 		Cluster: &models.Cluster{
-			Name:   d.Get("name").(string),
+			Name:   name,
 			Spec:   clusterSpec,
 			Type:   "kubernetes",
 			Labels: metakubeResourceClusterLabels(d),
@@ -148,7 +189,15 @@ func metakubeResourceClusterCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	projectID := d.Get("project_id").(string)
 	sshkeys := metakubeResourceClusterSSHKeys(d)
+	if names := metakubeResourceClusterAutoAttachSSHKeys(d); len(names) > 0 {
+		ids, diagnostics := metakubeResourceClusterResolveSSHKeyNames(ctx, meta, projectID, names)
+		if diagnostics != nil {
+			return append(retDiags, diagnostics...)
+		}
+		sshkeys = ids
+	}
 	if len(sshkeys) > 0 && !d.Get("spec.0.enable_ssh_agent").(bool) {
 		return append(retDiags, diag.Diagnostic{
 			Severity:      diag.Error,
@@ -157,25 +206,88 @@ func metakubeResourceClusterCreate(ctx context.Context, d *schema.ResourceData,
 		})
 	}
 
-	projectID := d.Get("project_id").(string)
-	p := project.NewCreateClusterV2Params().WithProjectID(projectID).WithBody(createClusterSpec)
+	// Mark the cluster with a label unique to this request, so that it can be found if create reports an error
+	// after the cluster was actually created, e.g. because of a client side timeout.
+	createUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if createClusterSpec.Cluster.Labels == nil {
+		createClusterSpec.Cluster.Labels = make(map[string]string)
+	}
+	createClusterSpec.Cluster.Labels[clusterCreateUUIDLabelName] = createUUID
+
+	createCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	p := project.NewCreateClusterV2Params().WithContext(createCtx).WithProjectID(projectID).WithBody(createClusterSpec)
 	r, err := meta.client.Project.CreateClusterV2(p, meta.auth)
 	if err != nil {
-		return diag.Errorf("unable to create cluster for project '%s': %s", projectID, stringifyResponseError(err))
+		if !metakubeResourceClusterCreateMayHaveSucceeded(err) {
+			return diag.Errorf("unable to create cluster for project '%s': %s", projectID, stringifyResponseError(err))
+		}
+		// Adopt such a cluster instead of leaving it orphaned and creating duplicate on the next apply.
+		created := metakubeResourceClusterFindCreated(meta, projectID, createUUID)
+		if created == nil {
+			return diag.Errorf("unable to create cluster for project '%s': %s", projectID, stringifyResponseError(err))
+		}
+		meta.log.Infof("cluster create request failed, but cluster '%s' was created: %s", created.ID, stringifyResponseError(err))
+		d.SetId(created.ID)
+	} else {
+		d.SetId(r.Payload.ID)
 	}
-	d.SetId(r.Payload.ID)
 
-	if diags := assignSSHKeysToCluster(projectID, r.Payload.ID, sshkeys, meta); diags != nil {
+	if diags := assignSSHKeysToCluster(projectID, d.Id(), sshkeys, meta); diags != nil {
 		return diags
 	}
 
-	if err := metakubeResourceClusterWaitForReady(ctx, meta, d, projectID, d.Id()); err != nil {
-		return diag.Errorf("cluster '%s' is not ready: %v", r.Payload.ID, err)
+	if err := metakubeResourceClusterWaitForReady(ctx, meta, d.Timeout(schema.TimeoutCreate), projectID, d.Id()); err != nil {
+		return metakubeResourceClusterNotReadyDiagnostics(meta, projectID, d.Id(), err)
 	}
 
 	return metakubeResourceClusterRead(ctx, d, m)
 }
 
+// metakubeResourceClusterCreateMayHaveSucceeded returns false if API rejected create request,
+// i.e. for client errors. Transport errors, timeouts and server errors leave the outcome unknown.
+func metakubeResourceClusterCreateMayHaveSucceeded(err error) bool {
+	switch e := err.(type) {
+	case *project.CreateClusterV2Unauthorized, *project.CreateClusterV2Forbidden:
+		return false
+	case *project.CreateClusterV2Default:
+		return e.Code() >= http.StatusInternalServerError
+	case *runtime.APIError:
+		return e.Code >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// metakubeResourceClusterFindCreated looks up a cluster labeled with given create request UUID.
+// Returns nil if cluster did not show up within a minute.
+func metakubeResourceClusterFindCreated(k *metakubeProviderMeta, projectID, createUUID string) *models.Cluster {
+	// Context of the failed create request might be expired already.
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	var ret *models.Cluster
+	err := resource.RetryContext(ctx, time.Minute, func() *resource.RetryError {
+		p := project.NewListClustersV2Params().WithContext(ctx).WithProjectID(projectID)
+		r, err := k.client.Project.ListClustersV2(p, k.auth)
+		if err != nil {
+			return resource.RetryableError(fmt.Errorf("list clusters: %s", stringifyResponseError(err)))
+		}
+		for _, c := range r.Payload {
+			if c != nil && c.Labels[clusterCreateUUIDLabelName] == createUUID && time.Time(c.DeletionTimestamp).IsZero() {
+				ret = c
+				return nil
+			}
+		}
+		return resource.RetryableError(fmt.Errorf("cluster labeled '%s=%s' not found", clusterCreateUUIDLabelName, createUUID))
+	})
+	if err != nil {
+		k.log.Debugf("lookup created cluster: %v", err)
+	}
+	return ret
+}
+
 func metakubeResourceClusterLabels(d *schema.ResourceData) map[string]string {
 	var labels map[string]string
 	if v := d.Get("labels"); v != nil {
@@ -196,6 +308,54 @@ func metakubeResourceClusterSSHKeys(d *schema.ResourceData) []string {
 	return ret
 }
 
+func metakubeResourceClusterAutoAttachSSHKeys(d *schema.ResourceData) []string {
+	var ret []string
+	for _, v := range d.Get("auto_attach_ssh_keys").(*schema.Set).List() {
+		ret = append(ret, v.(string))
+	}
+	return ret
+}
+
+// metakubeResourceClusterResolveSSHKeyNames returns ids of project SSH keys with given names.
+func metakubeResourceClusterResolveSSHKeyNames(ctx context.Context, k *metakubeProviderMeta, projectID string, names []string) ([]string, diag.Diagnostics) {
+	p := project.NewListSSHKeysParams().WithContext(ctx).WithProjectID(projectID)
+	r, err := k.client.Project.ListSSHKeys(p, k.auth)
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("List project keys error %v", stringifyResponseError(err)),
+			AttributePath: cty.GetAttrPath("auto_attach_ssh_keys"),
+		}}
+	}
+
+	found := make(map[string][]string)
+	for _, v := range r.Payload {
+		found[v.Name] = append(found[v.Name], v.ID)
+	}
+
+	var ids []string
+	var diagnostics diag.Diagnostics
+	for _, name := range names {
+		switch len(found[name]) {
+		case 0:
+			diagnostics = append(diagnostics, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("SSH key '%s' not found in project '%s'", name, projectID),
+				AttributePath: cty.GetAttrPath("auto_attach_ssh_keys"),
+			})
+		case 1:
+			ids = append(ids, found[name][0])
+		default:
+			diagnostics = append(diagnostics, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Multiple SSH keys named '%s' found in project '%s'", name, projectID),
+				AttributePath: cty.GetAttrPath("auto_attach_ssh_keys"),
+			})
+		}
+	}
+	return ids, diagnostics
+}
+
 func metakubeResourceClusterFindDatacenterByName(k *metakubeProviderMeta, d *schema.ResourceData) (*models.Datacenter, diag.Diagnostics) {
 	name := d.Get("dc_name").(string)
 	p := datacenter.NewListDatacentersParams()
@@ -243,7 +403,7 @@ func metakubeResourceClusterIsAWS(d *schema.ResourceData) bool {
 }
 
 func metakubeResourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	k := m.(*metakubeProviderMeta)
+	k := m.(*metakubeProviderMeta).withModuleAttribution(d)
 	projectID := d.Get("project_id").(string)
 	if projectID == "" {
 		var err error
@@ -306,16 +466,19 @@ func metakubeResourceClusterRead(ctx context.Context, d *schema.ResourceData, m
 
 	_ = d.Set("deletion_timestamp", r.Payload.DeletionTimestamp.String())
 
-	keys, diagnostics := metakubeClusterGetAssignedSSHKeys(ctx, d, k)
-	if diagnostics != nil {
-		return diagnostics
-	}
-	if err := d.Set("sshkeys", keys); err != nil {
-		return diag.Diagnostics{{
-			Severity:      diag.Error,
-			Summary:       "Invalid value",
-			AttributePath: cty.GetAttrPath("sshkeys"),
-		}}
+	// Keys attached by name are not tracked by id, otherwise they would show up as a diff on sshkeys.
+	if len(metakubeResourceClusterAutoAttachSSHKeys(d)) == 0 {
+		keys, diagnostics := metakubeClusterGetAssignedSSHKeys(ctx, d, k)
+		if diagnostics != nil {
+			return diagnostics
+		}
+		if err := d.Set("sshkeys", keys); err != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid value",
+				AttributePath: cty.GetAttrPath("sshkeys"),
+			}}
+		}
 	}
 
 	kubeConfigParams := project.NewGetClusterKubeconfigV2Params()
@@ -399,6 +562,7 @@ func metakubeResourceClusterExcludeSystemLabels(k *metakubeProviderMeta, project
 	for key := range r.Payload.Labels {
 		delete(labels, key)
 	}
+	delete(labels, clusterCreateUUIDLabelName)
 
 	return labels, nil
 }
@@ -491,7 +655,7 @@ func readClusterPreserveValues(d *schema.ResourceData) clusterPreserveValues {
 }
 
 func metakubeResourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	k := m.(*metakubeProviderMeta)
+	k := m.(*metakubeProviderMeta).withModuleAttribution(d)
 	projectID := d.Get("project_id").(string)
 
 	retDiags := metakubeResourceClusterValidateClusterFields(ctx, d, k)
@@ -513,9 +677,14 @@ func metakubeResourceClusterUpdate(ctx context.Context, d *schema.ResourceData,
 			return err
 		}
 	}
+	if d.HasChange("auto_attach_ssh_keys") {
+		if err := updateClusterAutoAttachSSHKeys(ctx, d, k); err != nil {
+			return err
+		}
+	}
 
-	if err := metakubeResourceClusterWaitForReady(ctx, k, d, projectID, d.Id()); err != nil {
-		return diag.Errorf("cluster '%s' is not ready: %v", d.Id(), err)
+	if err := metakubeResourceClusterWaitForReady(ctx, k, d.Timeout(schema.TimeoutUpdate), projectID, d.Id()); err != nil {
+		return metakubeResourceClusterNotReadyDiagnostics(k, projectID, d.Id(), err)
 	}
 
 	return metakubeResourceClusterRead(ctx, d, m)
@@ -591,10 +760,60 @@ func updateClusterSSHKeys(ctx context.Context, d *schema.ResourceData, k *metaku
 		}
 	}
 
-	for _, id := range unassigned {
+	if err := detachSSHKeysFromCluster(projectID, d.Id(), unassigned, k); err != nil {
+		return err
+	}
+
+	if err := assignSSHKeysToCluster(projectID, d.Id(), assign, k); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func updateClusterAutoAttachSSHKeys(ctx context.Context, d *schema.ResourceData, k *metakubeProviderMeta) diag.Diagnostics {
+	projectID := d.Get("project_id").(string)
+	var unassigned, assign []string
+	prev, cur := d.GetChange("auto_attach_ssh_keys")
+
+	for _, name := range prev.(*schema.Set).List() {
+		if !cur.(*schema.Set).Contains(name) {
+			unassigned = append(unassigned, name.(string))
+		}
+	}
+
+	for _, name := range cur.(*schema.Set).List() {
+		if !prev.(*schema.Set).Contains(name) {
+			assign = append(assign, name.(string))
+		}
+	}
+
+	if len(unassigned) > 0 {
+		// Keys might have been deleted from the project in the meantime, detach the ones still there.
+		ids, _ := metakubeResourceClusterResolveSSHKeyNames(ctx, k, projectID, unassigned)
+		if err := detachSSHKeysFromCluster(projectID, d.Id(), ids, k); err != nil {
+			return err
+		}
+	}
+
+	if len(assign) > 0 {
+		ids, diagnostics := metakubeResourceClusterResolveSSHKeyNames(ctx, k, projectID, assign)
+		if diagnostics != nil {
+			return diagnostics
+		}
+		if err := assignSSHKeysToCluster(projectID, d.Id(), ids, k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func detachSSHKeysFromCluster(projectID, clusterID string, sshkeyIDs []string, k *metakubeProviderMeta) diag.Diagnostics {
+	for _, id := range sshkeyIDs {
 		p := project.NewDetachSSHKeyFromClusterV2Params()
 		p.SetProjectID(projectID)
-		p.SetClusterID(d.Id())
+		p.SetClusterID(clusterID)
 		p.SetKeyID(id)
 		_, err := k.client.Project.DetachSSHKeyFromClusterV2(p, k.auth)
 		if err != nil {
@@ -605,10 +824,6 @@ func updateClusterSSHKeys(ctx context.Context, d *schema.ResourceData, k *metaku
 		}
 	}
 
-	if err := assignSSHKeysToCluster(projectID, d.Id(), assign, k); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -628,8 +843,8 @@ func assignSSHKeysToCluster(projectID, clusterID string, sshkeyIDs []string, k *
 	return nil
 }
 
-func metakubeResourceClusterWaitForReady(ctx context.Context, k *metakubeProviderMeta, d *schema.ResourceData, projectID, clusterID string) error {
-	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+func metakubeResourceClusterWaitForReady(ctx context.Context, k *metakubeProviderMeta, timeout time.Duration, projectID, clusterID string) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 
 		p := project.NewGetClusterHealthV2Params()
 		p.SetContext(ctx)
@@ -638,31 +853,92 @@ func metakubeResourceClusterWaitForReady(ctx context.Context, k *metakubeProvide
 
 		r, err := k.client.Project.GetClusterHealthV2(p, k.auth)
 		if err != nil {
-			return resource.RetryableError(fmt.Errorf("unable to get cluster '%s' health: %s", d.Id(), stringifyResponseError(err)))
+			return resource.RetryableError(fmt.Errorf("unable to get cluster '%s' health: %s", clusterID, stringifyResponseError(err)))
 		}
 
-		const up models.HealthStatus = 1
-
-		if r.Payload.Apiserver == up &&
-			r.Payload.CloudProviderInfrastructure == up &&
-			r.Payload.Controller == up &&
-			r.Payload.Etcd == up &&
-			r.Payload.MachineController == up &&
-			r.Payload.Scheduler == up &&
-			r.Payload.UserClusterControllerManager == up {
+		if metakubeClusterHealthy(r.Payload) {
 			return nil
 		}
 
-		k.log.Debugf("waiting for cluster '%s' to be ready, %+v", d.Id(), r.Payload)
-		return resource.RetryableError(fmt.Errorf("waiting for cluster '%s' to be ready", d.Id()))
+		k.log.Debugf("waiting for cluster '%s' to be ready, %+v", clusterID, r.Payload)
+		return resource.RetryableError(fmt.Errorf("waiting for cluster '%s' to be ready", clusterID))
+	})
+}
+
+// metakubeClusterHealthy returns true when all control plane components are up.
+func metakubeClusterHealthy(h *models.ClusterHealth) bool {
+	const up models.HealthStatus = 1
+
+	return h != nil &&
+		h.Apiserver == up &&
+		h.CloudProviderInfrastructure == up &&
+		h.Controller == up &&
+		h.Etcd == up &&
+		h.MachineController == up &&
+		h.Scheduler == up &&
+		h.UserClusterControllerManager == up
+}
+
+// metakubeResourceClusterNotReadyDiagnostics includes recent warning events of the cluster
+// to give a hint why control plane did not become healthy.
+func metakubeResourceClusterNotReadyDiagnostics(k *metakubeProviderMeta, projectID, clusterID string, err error) diag.Diagnostics {
+	// Context of the operation is most likely done at this point.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	ret := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("cluster '%s' is not ready: %v", clusterID, err),
+	}
+	eventType := "warning"
+	p := project.NewGetClusterEventsV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(clusterID).WithType(&eventType)
+	r, eventsErr := k.client.Project.GetClusterEventsV2(p, k.auth)
+	if eventsErr != nil {
+		k.log.Debugf("get cluster events: %s", stringifyResponseError(eventsErr))
+		return diag.Diagnostics{ret}
+	}
+	if events := metakubeClusterEventsDetail(r.Payload, 10); events != "" {
+		ret.Detail = "Recent cluster warning events:\n" + events
+	}
+	return diag.Diagnostics{ret}
+}
+
+// metakubeClusterEventsDetail formats up to limit most recent events, one per line.
+func metakubeClusterEventsDetail(events []*models.Event, limit int) string {
+	var sorted []*models.Event
+	for _, e := range events {
+		if e != nil {
+			sorted = append(sorted, e)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return time.Time(sorted[i].LastTimestamp).After(time.Time(sorted[j].LastTimestamp))
 	})
+	if len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+
+	var b strings.Builder
+	for _, e := range sorted {
+		b.WriteString(time.Time(e.LastTimestamp).UTC().Format(time.RFC3339))
+		if o := e.InvolvedObject; o != nil && o.Name != "" {
+			fmt.Fprintf(&b, " %s/%s", o.Type, o.Name)
+		}
+		fmt.Fprintf(&b, ": %s", e.Message)
+		if e.Count > 1 {
+			fmt.Fprintf(&b, " (x%d)", e.Count)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
 }
 
 func metakubeResourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	k := m.(*metakubeProviderMeta)
+	k := m.(*metakubeProviderMeta).withModuleAttribution(d)
 	projectID := d.Get("project_id").(string)
 	p := project.NewDeleteClusterV2Params()
 
+	p.SetContext(ctx)
 	p.SetProjectID(projectID)
 	p.SetClusterID(d.Id())
 
@@ -688,6 +964,7 @@ func metakubeResourceClusterDelete(ctx context.Context, d *schema.ResourceData,
 		}
 		p := project.NewGetClusterV2Params()
 
+		p.SetContext(ctx)
 		p.SetProjectID(projectID)
 		p.SetClusterID(d.Id())
 
@@ -1,12 +1,17 @@
 package metakube
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -774,3 +779,44 @@ func testAccCheckMetaKubeClusterExists(cluster *models.Cluster) resource.TestChe
 		return nil
 	}
 }
+
+func TestMetakubeClusterEventsDetail(t *testing.T) {
+	at := func(m int) strfmt.DateTime {
+		return strfmt.DateTime(time.Date(2021, 8, 24, 10, m, 0, 0, time.UTC))
+	}
+	events := []*models.Event{
+		{Message: "old", LastTimestamp: at(1)},
+		nil,
+		{Message: "quota exceeded", LastTimestamp: at(3), Count: 4, InvolvedObject: &models.ObjectReferenceResource{Type: "Cluster", Name: "abc"}},
+		{Message: "image missing", LastTimestamp: at(2)},
+	}
+
+	want := "2021-08-24T10:03:00Z Cluster/abc: quota exceeded (x4)\n" +
+		"2021-08-24T10:02:00Z: image missing\n"
+	if diff := cmp.Diff(want, metakubeClusterEventsDetail(events, 2)); diff != "" {
+		t.Fatalf("unexpected events detail: mismatch (-want +got):\n%s", diff)
+	}
+	if got := metakubeClusterEventsDetail(nil, 2); got != "" {
+		t.Fatalf("expected empty detail, got %q", got)
+	}
+}
+
+func TestMetakubeResourceClusterCreateMayHaveSucceeded(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{err: errors.New("context deadline exceeded"), want: true},
+		{err: project.NewCreateClusterV2Default(http.StatusBadGateway), want: true},
+		{err: runtime.NewAPIError("unknown error", nil, http.StatusServiceUnavailable), want: true},
+		{err: project.NewCreateClusterV2Default(http.StatusBadRequest), want: false},
+		{err: project.NewCreateClusterV2Unauthorized(), want: false},
+		{err: project.NewCreateClusterV2Forbidden(), want: false},
+		{err: runtime.NewAPIError("unknown error", nil, http.StatusConflict), want: false},
+	}
+	for _, c := range cases {
+		if got := metakubeResourceClusterCreateMayHaveSucceeded(c.err); got != c.want {
+			t.Errorf("%v: want %v, got %v", c.err, c.want, got)
+		}
+	}
+}
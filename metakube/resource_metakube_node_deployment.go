@@ -3,7 +3,9 @@ package metakube
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/syseleven/go-metakube/client/project"
 	"github.com/syseleven/go-metakube/client/versions"
 	"github.com/syseleven/go-metakube/models"
@@ -35,9 +38,15 @@ func metakubeResourceNodeDeployment() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		CustomizeDiff: customdiff.All(
 			validateNodeSpecMatchesCluster(),
 			validateAutoscalerFields(),
+			trackClusterKubeletVersion(),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -56,11 +65,22 @@ func metakubeResourceNodeDeployment() *schema.Resource {
 			},
 
 			"name": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
-				Description: "Node deployment name",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				Description:   "Node deployment name, generated by MetaKube if not set",
+			},
+
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				// Generated suffix is 26 characters long, node names are limited to 63.
+				ValidateFunc: validation.StringLenBetween(1, 37),
+				Description:  "Creates a unique node deployment name beginning with the specified prefix",
 			},
 
 			"spec": {
@@ -73,6 +93,37 @@ func metakubeResourceNodeDeployment() *schema.Resource {
 				},
 			},
 
+			"wait_for_ready_ratio": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      1.0,
+				ValidateFunc: validation.FloatBetween(0, 1),
+				Description:  "Share of replicas that must be ready for create and update to succeed",
+			},
+
+			"auto_upgrade_kubelet": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"spec.0.template.0.versions.0.kubelet"},
+				Description:   "Upgrade kubelet to the cluster version whenever they differ",
+			},
+
+			"kubelet_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Kubelet version of the node deployment",
+			},
+
+			"status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Most recently observed status of the node deployment",
+				Elem: &schema.Resource{
+					Schema: metakubeResourceNodeDeploymentStatusFields(),
+				},
+			},
+
 			"creation_timestamp": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -89,7 +140,7 @@ func metakubeResourceNodeDeployment() *schema.Resource {
 }
 
 func metakubeResourceNodeDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	k := m.(*metakubeProviderMeta)
+	k := m.(*metakubeProviderMeta).withModuleAttribution(d)
 	clusterID := d.Get("cluster_id").(string)
 	projectID := d.Get("project_id").(string)
 	if projectID == "" {
@@ -104,8 +155,12 @@ func metakubeResourceNodeDeploymentCreate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	name := d.Get("name").(string)
+	if v, ok := d.GetOk("name_prefix"); ok {
+		name = resource.PrefixedUniqueId(v.(string))
+	}
 	nodeDeployment := &models.NodeDeployment{
-		Name: d.Get("name").(string),
+		Name: name,
 		Spec: metakubeNodeDeploymentExpandSpec(d.Get("spec").([]interface{})),
 	}
 
@@ -113,13 +168,17 @@ func metakubeResourceNodeDeploymentCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	if diags := metakubeResourceNodeDeploymentPreflight(ctx, k, projectID, clusterID, nodeDeployment.Spec); diags.HasError() {
+		return diags
+	}
+
 	p := project.NewCreateMachineDeploymentParams().
 		WithContext(ctx).
 		WithProjectID(projectID).
 		WithClusterID(clusterID).
 		WithBody(nodeDeployment)
 
-	if err := metakubeResourceClusterWaitForReady(ctx, k, d, projectID, clusterID); err != nil {
+	if err := metakubeResourceClusterWaitForReady(ctx, k, d.Timeout(schema.TimeoutCreate), projectID, clusterID); err != nil {
 		return diag.Errorf("cluster is not ready: %v", err)
 	}
 
@@ -151,8 +210,11 @@ func metakubeResourceNodeDeploymentCreate(ctx context.Context, d *schema.Resourc
 	d.SetId(r.Payload.ID)
 	d.Set("project_id", projectID)
 
-	if err := metakubeResourceNodeDeploymentWaitForReady(ctx, k, d.Timeout(schema.TimeoutCreate), projectID, clusterID, r.Payload.ID, 0); err != nil {
-		return diag.FromErr(err)
+	// Paused node deployment is not reconciled, so there is nothing to wait for.
+	if !nodeDeployment.Spec.Paused {
+		if err := metakubeResourceNodeDeploymentWaitForReady(ctx, k, d.Timeout(schema.TimeoutCreate), projectID, clusterID, r.Payload.ID, 0, d.Get("wait_for_ready_ratio").(float64)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return metakubeResourceNodeDeploymentRead(ctx, d, m)
@@ -160,7 +222,7 @@ func metakubeResourceNodeDeploymentCreate(ctx context.Context, d *schema.Resourc
 }
 
 func metakubeResourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	k := m.(*metakubeProviderMeta)
+	k := m.(*metakubeProviderMeta).withModuleAttribution(d)
 	projectID := d.Get("project_id").(string)
 	clusterID := d.Get("cluster_id").(string)
 	p := project.NewGetMachineDeploymentParams().
@@ -186,8 +248,13 @@ func metakubeResourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceD
 
 	_ = d.Set("name", r.Payload.Name)
 
+	metakubeNodeDeploymentPreserveRHELCredentials(d, r.Payload.Spec)
 	_ = d.Set("spec", metakubeNodeDeploymentFlattenSpec(r.Payload.Spec))
 
+	_ = d.Set("status", metakubeNodeDeploymentFlattenStatus(r.Payload.Status))
+
+	_ = d.Set("kubelet_version", metakubeNodeDeploymentKubeletVersion(r.Payload.Spec))
+
 	_ = d.Set("creation_timestamp", r.Payload.CreationTimestamp.String())
 
 	_ = d.Set("deletion_timestamp", r.Payload.DeletionTimestamp.String())
@@ -195,8 +262,26 @@ func metakubeResourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
+// metakubeNodeDeploymentPreserveRHELCredentials keeps configured subscription credentials in case API does not return them.
+func metakubeNodeDeploymentPreserveRHELCredentials(d *schema.ResourceData, spec *models.NodeDeploymentSpec) {
+	if spec == nil || spec.Template == nil || spec.Template.OperatingSystem == nil || spec.Template.OperatingSystem.Rhel == nil {
+		return
+	}
+	rhel := spec.Template.OperatingSystem.Rhel
+	const prefix = "spec.0.template.0.operating_system.0.rhel.0."
+	if rhel.RHELSubscriptionManagerUser == "" {
+		rhel.RHELSubscriptionManagerUser = d.Get(prefix + "subscription_manager_user").(string)
+	}
+	if rhel.RHELSubscriptionManagerPassword == "" {
+		rhel.RHELSubscriptionManagerPassword = d.Get(prefix + "subscription_manager_password").(string)
+	}
+	if rhel.RHSMOfflineToken == "" {
+		rhel.RHSMOfflineToken = d.Get(prefix + "offline_token").(string)
+	}
+}
+
 func metakubeResourceNodeDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	k := m.(*metakubeProviderMeta)
+	k := m.(*metakubeProviderMeta).withModuleAttribution(d)
 	projectID := d.Get("project_id").(string)
 	clusterID := d.Get("cluster_id").(string)
 
@@ -204,87 +289,85 @@ func metakubeResourceNodeDeploymentUpdate(ctx context.Context, d *schema.Resourc
 		Spec: metakubeNodeDeploymentExpandSpec(d.Get("spec").([]interface{})),
 	}
 
+	if d.Get("auto_upgrade_kubelet").(bool) && nodeDeployment.Spec != nil && nodeDeployment.Spec.Template != nil {
+		// Versions in spec are those last read from API, kubelet_version holds the planned cluster version.
+		// It is unknown until first read, keep spec versions then.
+		if v := d.Get("kubelet_version").(string); v != "" {
+			nodeDeployment.Spec.Template.Versions = &models.NodeVersionInfo{Kubelet: v}
+		}
+	}
+
 	if err := metakubeResourceNodeDeploymentVersionCompatibleWithCluster(ctx, k, projectID, clusterID, nodeDeployment); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("spec.0.template.0.cloud") {
+		if diags := metakubeResourceNodeDeploymentPreflight(ctx, k, projectID, clusterID, nodeDeployment.Spec); diags.HasError() {
+			return diags
+		}
+	}
+
+	// Only changed fields are sent, so values defaulted by the backend, like resolved images, are kept as they are.
+	// Removed keys, like deleted labels, are sent with null values.
+	before, _ := d.GetChange("spec")
+	patch, err := metakubeNodeDeploymentSpecPatch(metakubeNodeDeploymentExpandSpec(before.([]interface{})), nodeDeployment.Spec)
+	if err != nil {
+		return diag.Errorf("unable to update a node deployment: %v", err)
+	}
+	if len(patch) == 0 {
+		return metakubeResourceNodeDeploymentRead(ctx, d, m)
+	}
+
 	p := project.NewPatchMachineDeploymentParams()
 	p.SetContext(ctx)
 	p.SetProjectID(projectID)
 	p.SetClusterID(clusterID)
 	p.SetMachineDeploymentID(d.Id())
-	p.SetPatch(nodeDeployment)
-	res, err := k.client.Project.PatchMachineDeployment(p, k.auth)
-	if err != nil {
-		return diag.Errorf("unable to update a node deployment: %v", stringifyResponseError(err))
-	}
-
-	if d.HasChange("spec.0.template.0.labels") {
-		// To delete a label key we have to send PATCH request with that key set to null.
-		// For simplicity we are doing it in a separate PATCH.
-
-		before, now := d.GetChange("spec.0.template.0.labels")
+	p.SetPatch(map[string]interface{}{"spec": patch})
 
-		var beforeMap, nowMap map[string]interface{}
-		var ok bool
-
-		if before != nil {
-			beforeMap, ok = before.(map[string]interface{})
-			if !ok {
-				return diag.Errorf("failed to apply labels change")
+	var res *project.PatchMachineDeploymentOK
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		r, err := k.client.Project.PatchMachineDeployment(p, k.auth)
+		if err != nil {
+			if strings.Contains(stringifyResponseError(err), "the object has been modified") {
+				return resource.RetryableError(fmt.Errorf("machine deployment patch conflict: %v", err))
 			}
+			return resource.NonRetryableError(fmt.Errorf("patch machine deployment '%s': %v", d.Id(), stringifyResponseError(err)))
 		}
+		res = r
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("unable to update a node deployment: %v", err)
+	}
 
-		if now != nil {
-			nowMap, ok = now.(map[string]interface{})
-			if !ok {
-				return diag.Errorf("failed to apply labels change")
-			}
+	if !nodeDeployment.Spec.Paused {
+		if err := metakubeResourceNodeDeploymentWaitForReady(ctx, k, d.Timeout(schema.TimeoutUpdate), projectID, clusterID, d.Id(), res.Payload.Status.ObservedGeneration, d.Get("wait_for_ready_ratio").(float64)); err != nil {
+			return diag.FromErr(err)
 		}
+	}
 
-		labelsPatch := make(map[string]interface{})
-		for k := range beforeMap {
-			if _, ok := nowMap[k]; !ok {
-				labelsPatch[k] = nil
-			}
-		}
+	return metakubeResourceNodeDeploymentRead(ctx, d, m)
+}
 
-		if len(labelsPatch) > 0 {
-			patch := map[string]interface{}{
-				"spec": map[string]interface{}{
-					"template": map[string]interface{}{
-						"labels": labelsPatch,
-					},
-				},
-			}
-			p := project.NewPatchMachineDeploymentParams()
-			p.SetContext(ctx)
-			p.SetProjectID(projectID)
-			p.SetClusterID(clusterID)
-			p.SetMachineDeploymentID(d.Id())
-			p.SetPatch(&patch)
-
-			resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-				_, err := k.client.Project.PatchMachineDeployment(p, k.auth)
-				if err != nil {
-					if strings.Contains(stringifyResponseError(err), "the object has been modified") {
-						return resource.RetryableError(fmt.Errorf("machine deployment patch conflict: %v", err))
-					}
-					return resource.NonRetryableError(fmt.Errorf("patch machine deployment '%s': %v", d.Id(), err))
-				}
-				return nil
-			})
-			if err != nil {
-				return diag.Errorf("unable to update a node deployment: %v", stringifyResponseError(err))
-			}
-		}
+// metakubeNodeDeploymentSpecPatch returns JSON merge patch turning before spec into after spec.
+func metakubeNodeDeploymentSpecPatch(before, after *models.NodeDeploymentSpec) (map[string]interface{}, error) {
+	b, err := toJSONObject(before)
+	if err != nil {
+		return nil, err
 	}
-
-	if err := metakubeResourceNodeDeploymentWaitForReady(ctx, k, d.Timeout(schema.TimeoutCreate), projectID, clusterID, d.Id(), res.Payload.Status.ObservedGeneration); err != nil {
-		return diag.FromErr(err)
+	a, err := toJSONObject(after)
+	if err != nil {
+		return nil, err
 	}
+	return jsonMergePatch(b, a), nil
+}
 
-	return metakubeResourceNodeDeploymentRead(ctx, d, m)
+func metakubeNodeDeploymentKubeletVersion(spec *models.NodeDeploymentSpec) string {
+	if spec == nil || spec.Template == nil || spec.Template.Versions == nil {
+		return ""
+	}
+	return spec.Template.Versions.Kubelet
 }
 
 func metakubeResourceNodeDeploymentVersionCompatibleWithCluster(ctx context.Context, k *metakubeProviderMeta, projectID, clusterID string, ndepl *models.NodeDeployment) error {
@@ -358,10 +441,10 @@ func validateKubeletVersionIsAvailable(k *metakubeProviderMeta, kubeletVersion,
 	return fmt.Errorf("unknown version for node deployment %s, available versions %v", kubeletVersion, availableVersions)
 }
 
-func metakubeResourceNodeDeploymentWaitForReady(ctx context.Context, k *metakubeProviderMeta, timeout time.Duration, projectID, clusterID, id string, generation int64) error {
+func metakubeResourceNodeDeploymentWaitForReady(ctx context.Context, k *metakubeProviderMeta, timeout time.Duration, projectID, clusterID, id string, generation int64, readyRatio float64) error {
 	ensures := 0
 	needed := 2
-	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		p := project.NewGetMachineDeploymentParams().
 			WithContext(ctx).
 			WithProjectID(projectID).
@@ -373,7 +456,7 @@ func metakubeResourceNodeDeploymentWaitForReady(ctx context.Context, k *metakube
 			return resource.RetryableError(fmt.Errorf("unable to get node deployment %v", err))
 		}
 
-		if r.Payload.Status.ReadyReplicas < *r.Payload.Spec.Replicas || r.Payload.Status.UnavailableReplicas != 0 {
+		if !metakubeNodeDeploymentReadyEnough(*r.Payload.Spec.Replicas, r.Payload.Status, readyRatio) {
 			k.log.Debugf("waiting for node deployment '%s' to be ready, %+v", id, r.Payload.Status)
 			return resource.RetryableError(fmt.Errorf("waiting for node deployment '%s' to be ready", id))
 		} else {
@@ -385,10 +468,70 @@ func metakubeResourceNodeDeploymentWaitForReady(ctx context.Context, k *metakube
 		}
 		return nil
 	})
+	if err == nil {
+		return nil
+	}
+
+	// Machine controller reports provisioning failures, like exceeded quota or missing image, as warning events.
+	// Context of the operation is most likely done at this point.
+	eventsCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	p := project.NewListMachineDeploymentNodesEventsParams().
+		WithContext(eventsCtx).
+		WithProjectID(projectID).
+		WithClusterID(clusterID).
+		WithMachineDeploymentID(id).
+		WithType(strToPtr("warning"))
+	r, eventsErr := k.client.Project.ListMachineDeploymentNodesEvents(p, k.auth)
+	if eventsErr != nil {
+		k.log.Debugf("unable to list events of node deployment '%s': %v", id, stringifyResponseError(eventsErr))
+		return err
+	}
+	if msgs := metakubeNodeDeploymentEventMessages(r.Payload, 5); len(msgs) > 0 {
+		return fmt.Errorf("%v, recent warnings: %s", err, strings.Join(msgs, "; "))
+	}
+	return err
+}
+
+// metakubeNodeDeploymentEventMessages returns up to limit distinct event messages, most recent first.
+func metakubeNodeDeploymentEventMessages(events []*models.Event, limit int) []string {
+	sorted := make([]*models.Event, 0, len(events))
+	for _, e := range events {
+		if e != nil && e.Message != "" {
+			sorted = append(sorted, e)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return time.Time(sorted[i].LastTimestamp).After(time.Time(sorted[j].LastTimestamp))
+	})
+
+	var ret []string
+	seen := make(map[string]bool)
+	for _, e := range sorted {
+		if len(ret) == limit {
+			break
+		}
+		if seen[e.Message] {
+			continue
+		}
+		seen[e.Message] = true
+		ret = append(ret, e.Message)
+	}
+	return ret
+}
+
+// metakubeNodeDeploymentReadyEnough returns true when at least readyRatio of replicas are ready
+// and the rest is the only thing missing for the node deployment to be available.
+func metakubeNodeDeploymentReadyEnough(replicas int32, status *models.MachineDeploymentStatus, readyRatio float64) bool {
+	if status == nil {
+		return false
+	}
+	required := int32(math.Ceil(float64(replicas) * readyRatio))
+	return status.ReadyReplicas >= required && status.UnavailableReplicas <= replicas-required
 }
 
 func metakubeResourceNodeDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	k := m.(*metakubeProviderMeta)
+	k := m.(*metakubeProviderMeta).withModuleAttribution(d)
 	projectID := d.Get("project_id").(string)
 	clusterID := d.Get("cluster_id").(string)
 	p := project.NewDeleteMachineDeploymentParams().
@@ -37,6 +37,49 @@ func matakubeResourceNodeDeploymentValidateLabelOrTag(key string) error {
 	return nil
 }
 
+var nodeDeploymentOperatingSystems = []string{
+	"spec.0.template.0.operating_system.0.ubuntu",
+	"spec.0.template.0.operating_system.0.flatcar",
+	"spec.0.template.0.operating_system.0.rhel",
+	"spec.0.template.0.operating_system.0.sles",
+	"spec.0.template.0.operating_system.0.centos",
+}
+
+func metakubeResourceNodeDeploymentStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"replicas": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of nodes targeted by the node deployment",
+		},
+		"ready_replicas": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of ready nodes",
+		},
+		"available_replicas": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of available nodes",
+		},
+		"unavailable_replicas": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of nodes that are still required for the node deployment to be available",
+		},
+		"updated_replicas": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of nodes running the current template",
+		},
+		"observed_generation": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Generation of the node deployment observed by the controller",
+		},
+	}
+}
+
 func matakubeResourceNodeDeploymentSpecFields() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"dynamic_config": {
@@ -44,23 +87,31 @@ func matakubeResourceNodeDeploymentSpecFields() map[string]*schema.Schema {
 			Optional:    true,
 			Default:     false,
 			Description: "Enable metakube kubelete dynamic config",
+			Deprecated:  "Dynamic kubelet config is deprecated in Kubernetes 1.22 and will be removed in a future release",
+		},
+		"paused": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Pause rollout of node deployment changes",
 		},
 		"replicas": {
 			Type:          schema.TypeInt,
 			Optional:      true,
 			Default:       3,
-			Description:   "Number of replicas",
+			ValidateFunc:  validation.IntAtLeast(0),
+			Description:   "Number of replicas, set to 0 to scale node deployment down",
 			ConflictsWith: []string{"spec.0.min_replicas", "spec.0.max_replicas"},
 			DiffSuppressFunc: func(_, _, n string, d *schema.ResourceData) bool {
-				minv, ok1 := d.GetOkConfigured("spec.0.min_replicas")
-				maxv, ok2 := d.GetOkConfigured("spec.0.max_replicas")
-				return ok1 && minv.(int) > 0 && ok2 && maxv.(int) > 0
+				// min_replicas may be 0, autoscaling is enabled when max_replicas is set.
+				maxv, ok := d.GetOkConfigured("spec.0.max_replicas")
+				return ok && maxv.(int) > 0
 			},
 		},
 		"min_replicas": {
 			Type:         schema.TypeInt,
 			Optional:     true,
-			ValidateFunc: validation.IntAtLeast(1),
+			ValidateFunc: validation.IntAtLeast(0),
 			Description:  "Minimum number of replicas to downscale",
 			RequiredWith: []string{"spec.0.max_replicas"},
 		},
@@ -112,6 +163,78 @@ func matakubeResourceNodeDeploymentSpecFields() map[string]*schema.Schema {
 									},
 								},
 								"azure": metakubeResourceNodeDeploymentAzureSchema(),
+								"gcp": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "Google Cloud node deployment specification",
+									Elem: &schema.Resource{
+										Schema: metakubeResourceNodeDeploymentGCPSchema(),
+									},
+								},
+								"vsphere": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "vSphere node deployment specification",
+									Elem: &schema.Resource{
+										Schema: metakubeResourceNodeDeploymentVSphereSchema(),
+									},
+								},
+								"hetzner": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "Hetzner node deployment specification",
+									Elem: &schema.Resource{
+										Schema: metakubeResourceNodeDeploymentHetznerSchema(),
+									},
+								},
+								"digitalocean": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "DigitalOcean node deployment specification",
+									Elem: &schema.Resource{
+										Schema: metakubeResourceNodeDeploymentDigitaloceanSchema(),
+									},
+								},
+								"anexia": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "Anexia node deployment specification",
+									Elem: &schema.Resource{
+										Schema: metakubeResourceNodeDeploymentAnexiaSchema(),
+									},
+								},
+								"kubevirt": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "KubeVirt node deployment specification",
+									Elem: &schema.Resource{
+										Schema: metakubeResourceNodeDeploymentKubevirtSchema(),
+									},
+								},
+								"equinix_metal": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "Equinix Metal node deployment specification",
+									Elem: &schema.Resource{
+										Schema: metakubeResourceNodeDeploymentEquinixMetalSchema(),
+									},
+								},
+								"alibaba": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "Alibaba Cloud node deployment specification",
+									Elem: &schema.Resource{
+										Schema: metakubeResourceNodeDeploymentAlibabaSchema(),
+									},
+								},
 							},
 						},
 					},
@@ -128,7 +251,7 @@ func matakubeResourceNodeDeploymentSpecFields() map[string]*schema.Schema {
 									Optional:     true,
 									MinItems:     1,
 									MaxItems:     1,
-									ExactlyOneOf: []string{"spec.0.template.0.operating_system.0.ubuntu", "spec.0.template.0.operating_system.0.flatcar"},
+									ExactlyOneOf: nodeDeploymentOperatingSystems,
 									Description:  "Ubuntu operating system",
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
@@ -146,7 +269,7 @@ func matakubeResourceNodeDeploymentSpecFields() map[string]*schema.Schema {
 									Optional:     true,
 									MinItems:     1,
 									MaxItems:     1,
-									ExactlyOneOf: []string{"spec.0.template.0.operating_system.0.ubuntu", "spec.0.template.0.operating_system.0.flatcar"},
+									ExactlyOneOf: nodeDeploymentOperatingSystems,
 									Description:  "Flatcar operating system",
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
@@ -159,6 +282,77 @@ func matakubeResourceNodeDeploymentSpecFields() map[string]*schema.Schema {
 										},
 									},
 								},
+								"rhel": {
+									Type:         schema.TypeList,
+									Optional:     true,
+									MinItems:     1,
+									MaxItems:     1,
+									ExactlyOneOf: nodeDeploymentOperatingSystems,
+									Description:  "RHEL operating system",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"dist_upgrade_on_boot": {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "Upgrade operating system on boot",
+											},
+											"subscription_manager_user": {
+												Type:        schema.TypeString,
+												Optional:    true,
+												Description: "Red Hat subscription manager user",
+											},
+											"subscription_manager_password": {
+												Type:        schema.TypeString,
+												Optional:    true,
+												Sensitive:   true,
+												Description: "Red Hat subscription manager password",
+											},
+											"offline_token": {
+												Type:        schema.TypeString,
+												Optional:    true,
+												Sensitive:   true,
+												Description: "Red Hat subscription management offline token",
+											},
+										},
+									},
+								},
+								"sles": {
+									Type:         schema.TypeList,
+									Optional:     true,
+									MinItems:     1,
+									MaxItems:     1,
+									ExactlyOneOf: nodeDeploymentOperatingSystems,
+									Description:  "SLES operating system",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"dist_upgrade_on_boot": {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "Upgrade operating system on boot",
+											},
+										},
+									},
+								},
+								"centos": {
+									Type:         schema.TypeList,
+									Optional:     true,
+									MinItems:     1,
+									MaxItems:     1,
+									ExactlyOneOf: nodeDeploymentOperatingSystems,
+									Description:  "CentOS operating system",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"dist_upgrade_on_boot": {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "Upgrade operating system on boot",
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -220,8 +414,8 @@ func matakubeResourceNodeDeploymentSpecFields() map[string]*schema.Schema {
 								},
 								"value": {
 									Type:        schema.TypeString,
-									Required:    true,
-									Description: "Taint value",
+									Optional:    true,
+									Description: "Taint value, can be omitted for key-only taints",
 								},
 							},
 						},
@@ -313,6 +507,12 @@ func matakubeResourceNodeDeploymentCloudOpenstackSchema() map[string]*schema.Sch
 			ValidateFunc: validation.IntAtLeast(1),
 			Description:  "If set, the rootDisk will be a volume. If not, the rootDisk will be on ephemeral storage and its size will be derived from the flavor",
 		},
+		"availability_zone": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Availability zone in which to place the node",
+		},
 		"tags": {
 			Type:        schema.TypeMap,
 			Optional:    true,
@@ -440,7 +640,7 @@ func metakubeResourceNodeDeploymentAzureSchema() *schema.Schema {
 					},
 				},
 				"zones": {
-					Type:        schema.TypeList,
+					Type:        schema.TypeSet,
 					Optional:    true,
 					Computed:    true,
 					Description: "Represents the availablity zones for azure vms",
@@ -450,3 +650,278 @@ func metakubeResourceNodeDeploymentAzureSchema() *schema.Schema {
 		},
 	}
 }
+
+// GCPNodeSpec has no network fields, nodes use network and subnetwork of the cluster.
+func metakubeResourceNodeDeploymentGCPSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"machine_type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "GCE machine type",
+		},
+		"disk_size": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Size of the boot disk in GB",
+		},
+		"disk_type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Type of the boot disk, e.g. pd-standard or pd-ssd",
+		},
+		"zone": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Zone in which to place the node",
+		},
+		"preemptible": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Create preemptible instances",
+		},
+		"custom_image": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Image to use. Will be defaulted to an image of your selected operating system",
+		},
+		"labels": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Computed:    true,
+			Description: "Additional instance labels",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return matakubeResourceNodeDeploymentLabelOrTagReserved(k)
+			},
+			ValidateFunc: func(v interface{}, k string) (strings []string, errors []error) {
+				l := v.(map[string]interface{})
+				for key := range l {
+					if err := matakubeResourceNodeDeploymentValidateLabelOrTag(key); err != nil {
+						errors = append(errors, err)
+					}
+				}
+				return
+			},
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Network tags of the instance",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// VSphereNodeSpec has no placement fields, nodes use datastore and folder of the cluster.
+func metakubeResourceNodeDeploymentVSphereSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cpus": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Number of virtual CPUs",
+		},
+		"memory": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Memory in MB",
+		},
+		"disk_size_gb": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Disk size in GB. Defaults to the size of the template disk",
+		},
+		"template": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "VM template to create the node from",
+		},
+	}
+}
+
+// HetznerNodeSpec has no labels field, servers can only be labeled through node labels.
+func metakubeResourceNodeDeploymentHetznerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Server type, e.g. cx21",
+		},
+		"network": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the network to attach the server to",
+		},
+	}
+}
+
+func metakubeResourceNodeDeploymentDigitaloceanSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"size": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Droplet size slug, e.g. s-2vcpu-4gb",
+		},
+		"backups": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable backups for the droplet",
+		},
+		"ipv6": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable IPv6 for the droplet",
+		},
+		"monitoring": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable monitoring for the droplet",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Additional droplet tags",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func metakubeResourceNodeDeploymentAnexiaSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"vlan_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "VLAN to attach the instance to",
+		},
+		"template_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Instance template",
+		},
+		"cpus": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Number of CPUs",
+		},
+		"memory": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Memory in MB",
+		},
+		"disk_size": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Disk size in GB",
+		},
+	}
+}
+
+// KubevirtNodeSpec has no instancetype or preference fields, resources are set explicitly.
+func metakubeResourceNodeDeploymentKubevirtSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cpus": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Number of CPUs, e.g. 2",
+		},
+		"memory": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Memory as Kubernetes quantity, e.g. 4Gi",
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Namespace the virtual machines are created in",
+		},
+		"pvc_size": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Size of the primary disk as Kubernetes quantity, e.g. 20Gi",
+		},
+		"storage_class_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Storage class of the primary disk",
+		},
+		"source_url": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "URL of the image the primary disk is imported from",
+		},
+	}
+}
+
+// PacketNodeSpec only has instance type and tags, facilities come from datacenter and billing cycle from cluster.
+func metakubeResourceNodeDeploymentEquinixMetalSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"plan": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Plan the device is provisioned with, e.g. c3.small.x86",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Additional device tags",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func metakubeResourceNodeDeploymentAlibabaSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "ECS instance type, e.g. ecs.c6.large",
+		},
+		"disk_size": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "System disk size in GB",
+		},
+		"disk_type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "System disk category, e.g. cloud_efficiency",
+		},
+		"vswitch_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "VSwitch to attach the instance to",
+		},
+		"zone_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Zone in which to place the instance",
+		},
+		"internet_max_bandwidth_out": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Maximum outbound public bandwidth in Mbit/s",
+		},
+		"labels": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Additional instance tags",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
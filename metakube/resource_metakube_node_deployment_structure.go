@@ -1,6 +1,9 @@
 package metakube
 
 import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/syseleven/go-metakube/models"
 )
 
@@ -16,11 +19,8 @@ func metakubeNodeDeploymentFlattenSpec(in *models.NodeDeploymentSpec) []interfac
 		att["replicas"] = *in.Replicas
 	}
 
-	if in.MinReplicas > 0 {
-		att["min_replicas"] = in.MinReplicas
-	}
-
 	if in.MaxReplicas > 0 {
+		att["min_replicas"] = in.MinReplicas
 		att["max_replicas"] = in.MaxReplicas
 	}
 
@@ -30,9 +30,28 @@ func metakubeNodeDeploymentFlattenSpec(in *models.NodeDeploymentSpec) []interfac
 
 	att["dynamic_config"] = in.DynamicConfig
 
+	att["paused"] = in.Paused
+
 	return []interface{}{att}
 }
 
+func metakubeNodeDeploymentFlattenStatus(in *models.MachineDeploymentStatus) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"replicas":             in.Replicas,
+			"ready_replicas":       in.ReadyReplicas,
+			"available_replicas":   in.AvailableReplicas,
+			"unavailable_replicas": in.UnavailableReplicas,
+			"updated_replicas":     in.UpdatedReplicas,
+			"observed_generation":  in.ObservedGeneration,
+		},
+	}
+}
+
 func metakubeNodeDeploymentFlattenNodeSpec(in *models.NodeSpec) []interface{} {
 	if in == nil {
 		return []interface{}{}
@@ -86,6 +105,18 @@ func metakubeNodeDeploymentFlattenOperatingSystem(in *models.OperatingSystemSpec
 		att["flatcar"] = metakubeNodeDeploymentFlattenFlatcar(in.Flatcar)
 	}
 
+	if in.Rhel != nil {
+		att["rhel"] = metakubeNodeDeploymentFlattenRHEL(in.Rhel)
+	}
+
+	if in.Sles != nil {
+		att["sles"] = metakubeNodeDeploymentFlattenSLES(in.Sles)
+	}
+
+	if in.Centos != nil {
+		att["centos"] = metakubeNodeDeploymentFlattenCentOS(in.Centos)
+	}
+
 	return []interface{}{att}
 }
 
@@ -113,6 +144,54 @@ func metakubeNodeDeploymentFlattenFlatcar(in *models.FlatcarSpec) []interface{}
 	return []interface{}{att}
 }
 
+func metakubeNodeDeploymentFlattenRHEL(in *models.RHELSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	att["dist_upgrade_on_boot"] = in.DistUpgradeOnBoot
+
+	if in.RHELSubscriptionManagerUser != "" {
+		att["subscription_manager_user"] = in.RHELSubscriptionManagerUser
+	}
+
+	if in.RHELSubscriptionManagerPassword != "" {
+		att["subscription_manager_password"] = in.RHELSubscriptionManagerPassword
+	}
+
+	if in.RHSMOfflineToken != "" {
+		att["offline_token"] = in.RHSMOfflineToken
+	}
+
+	return []interface{}{att}
+}
+
+func metakubeNodeDeploymentFlattenSLES(in *models.SLESSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	att["dist_upgrade_on_boot"] = in.DistUpgradeOnBoot
+
+	return []interface{}{att}
+}
+
+func metakubeNodeDeploymentFlattenCentOS(in *models.CentOSSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	att["dist_upgrade_on_boot"] = in.DistUpgradeOnBoot
+
+	return []interface{}{att}
+}
+
 func metakubeNodeDeploymentFlattenVersion(in *models.NodeVersionInfo) []interface{} {
 	if in == nil {
 		return []interface{}{}
@@ -138,9 +217,7 @@ func metakubeNodeDeploymentFlattenTaintSpec(in *models.TaintSpec) map[string]int
 		att["key"] = in.Key
 	}
 
-	if in.Value != "" {
-		att["value"] = in.Value
-	}
+	att["value"] = in.Value
 
 	if in.Effect != "" {
 		att["effect"] = in.Effect
@@ -168,6 +245,38 @@ func metakubeNodeDeploymentFlattenCloudSpec(in *models.NodeCloudSpec) []interfac
 		att["azure"] = metakubeNodeDeploymentFlattenAzureSpec(in.Azure)
 	}
 
+	if in.Gcp != nil {
+		att["gcp"] = metakubeNodeDeploymentFlattenGCPSpec(in.Gcp)
+	}
+
+	if in.Vsphere != nil {
+		att["vsphere"] = metakubeNodeDeploymentFlattenVSphereSpec(in.Vsphere)
+	}
+
+	if in.Hetzner != nil {
+		att["hetzner"] = metakubeNodeDeploymentFlattenHetznerSpec(in.Hetzner)
+	}
+
+	if in.Digitalocean != nil {
+		att["digitalocean"] = metakubeNodeDeploymentFlattenDigitaloceanSpec(in.Digitalocean)
+	}
+
+	if in.Anexia != nil {
+		att["anexia"] = metakubeNodeDeploymentFlattenAnexiaSpec(in.Anexia)
+	}
+
+	if in.Kubevirt != nil {
+		att["kubevirt"] = metakubeNodeDeploymentFlattenKubevirtSpec(in.Kubevirt)
+	}
+
+	if in.Packet != nil {
+		att["equinix_metal"] = metakubeNodeDeploymentFlattenEquinixMetalSpec(in.Packet)
+	}
+
+	if in.Alibaba != nil {
+		att["alibaba"] = metakubeNodeDeploymentFlattenAlibabaSpec(in.Alibaba)
+	}
+
 	return []interface{}{att}
 }
 
@@ -248,6 +357,10 @@ func metakubeNodeDeploymentFlattenOpenstackSpec(in *models.OpenstackNodeSpec) []
 		att["disk_size"] = in.RootDiskSizeGB
 	}
 
+	if in.AvailabilityZone != "" {
+		att["availability_zone"] = in.AvailabilityZone
+	}
+
 	return []interface{}{att}
 }
 
@@ -276,246 +389,973 @@ func metakubeNodeDeploymentFlattenAzureSpec(in *models.AzureNodeSpec) []interfac
 		att["tags"] = in.Tags
 	}
 
-	if in.Zones != nil {
-		att["zones"] = in.Zones
+	if l := len(in.Zones); l > 0 {
+		zones := make([]interface{}, l)
+		for i, z := range in.Zones {
+			zones[i] = z
+		}
+		att["zones"] = zones
 	}
 
 	return []interface{}{att}
 }
 
-// expanders
-
-func metakubeNodeDeploymentExpandSpec(p []interface{}) *models.NodeDeploymentSpec {
-	if len(p) < 1 {
-		return nil
+func metakubeNodeDeploymentFlattenGCPSpec(in *models.GCPNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
 	}
-	obj := &models.NodeDeploymentSpec{}
-	if p[0] == nil {
-		return obj
+
+	att := make(map[string]interface{})
+
+	if in.MachineType != "" {
+		att["machine_type"] = in.MachineType
 	}
 
-	in, ok := p[0].(map[string]interface{})
-	if !ok {
-		return obj
+	if in.DiskSize != 0 {
+		att["disk_size"] = in.DiskSize
 	}
 
-	if v, ok := in["min_replicas"]; ok {
-		if vv, ok := v.(int); ok {
-			obj.MinReplicas = int32(vv)
-		}
+	if in.DiskType != "" {
+		att["disk_type"] = in.DiskType
 	}
 
-	if v, ok := in["max_replicas"]; ok {
-		if vv, ok := v.(int); ok {
-			obj.MaxReplicas = int32(vv)
-		}
+	if in.Zone != "" {
+		att["zone"] = in.Zone
 	}
 
-	if v, ok := in["replicas"]; ok {
-		if vv, ok := v.(int); ok {
-			obj.Replicas = int32ToPtr(int32(vv))
-		}
+	att["preemptible"] = in.Preemptible
+
+	if in.CustomImage != "" {
+		att["custom_image"] = in.CustomImage
 	}
 
-	if v, ok := in["template"]; ok {
-		if vv, ok := v.([]interface{}); ok {
-			obj.Template = metakubeNodeDeploymentExpandNodeSpec(vv)
+	if l := len(in.Labels); l > 0 {
+		t := make(map[string]string, l)
+		for key, val := range in.Labels {
+			t[key] = val
 		}
+		att["labels"] = t
 	}
 
-	if v, ok := in["dynamic_config"]; ok {
-		if vv, ok := v.(bool); ok {
-			obj.DynamicConfig = vv
+	if l := len(in.Tags); l > 0 {
+		t := make([]interface{}, l)
+		for i, v := range in.Tags {
+			t[i] = v
 		}
+		att["tags"] = t
 	}
 
-	return obj
+	return []interface{}{att}
 }
 
-func metakubeNodeDeploymentExpandNodeSpec(p []interface{}) *models.NodeSpec {
-	if len(p) < 1 {
-		return nil
+func metakubeNodeDeploymentFlattenVSphereSpec(in *models.VSphereNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
 	}
-	obj := &models.NodeSpec{}
-	if p[0] == nil {
-		return obj
+
+	att := make(map[string]interface{})
+
+	if in.CPUs != 0 {
+		att["cpus"] = in.CPUs
 	}
 
-	in, ok := p[0].(map[string]interface{})
-	if !ok {
-		return obj
+	if in.Memory != 0 {
+		att["memory"] = in.Memory
 	}
 
-	if v, ok := in["labels"]; ok {
-		obj.Labels = make(map[string]string)
-		if vv, ok := v.(map[string]interface{}); ok {
-			for key, val := range vv {
-				if s, ok := val.(string); ok && s != "" {
-					obj.Labels[key] = s
-				}
-			}
-		}
+	if in.DiskSizeGB != 0 {
+		att["disk_size_gb"] = in.DiskSizeGB
 	}
 
-	if v, ok := in["operating_system"]; ok {
-		if vv, ok := v.([]interface{}); ok {
-			obj.OperatingSystem = metakubeNodeDeploymentExpandOS(vv)
-		}
+	if in.Template != "" {
+		att["template"] = in.Template
 	}
 
-	if v, ok := in["versions"]; ok {
-		if vv, ok := v.([]interface{}); ok {
-			obj.Versions = metakubeNodeDeploymentExpandVersion(vv)
-		}
+	return []interface{}{att}
+}
+
+func metakubeNodeDeploymentFlattenHetznerSpec(in *models.HetznerNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
 	}
 
-	if v, ok := in["taints"]; ok {
-		if vv, ok := v.([]interface{}); ok {
-			for _, t := range vv {
-				if tt, ok := t.(map[string]interface{}); ok {
-					obj.Taints = append(obj.Taints, metakubeNodeDeploymentExpandTaintSpec(tt))
-				}
-			}
-		}
+	att := make(map[string]interface{})
+
+	if in.Type != nil {
+		att["type"] = *in.Type
 	}
 
-	if v, ok := in["cloud"]; ok {
-		if vv, ok := v.([]interface{}); ok {
-			obj.Cloud = metakubeNodeDeploymentExpandCloudSpec(vv)
-		}
+	if in.Network != "" {
+		att["network"] = in.Network
 	}
 
-	return obj
+	return []interface{}{att}
 }
 
-func metakubeNodeDeploymentExpandOS(p []interface{}) *models.OperatingSystemSpec {
-	if len(p) < 1 {
-		return nil
-	}
-	obj := &models.OperatingSystemSpec{}
-	if p[0] == nil {
-		return obj
+func metakubeNodeDeploymentFlattenDigitaloceanSpec(in *models.DigitaloceanNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
 	}
 
-	in, ok := p[0].(map[string]interface{})
-	if !ok {
-		return obj
-	}
+	att := make(map[string]interface{})
 
-	if v, ok := in["ubuntu"]; ok {
-		if vv, ok := v.([]interface{}); ok {
-			obj.Ubuntu = metakubeNodeDeploymentExpandUbuntu(vv)
-		}
+	if in.Size != nil {
+		att["size"] = *in.Size
 	}
 
-	if v, ok := in["flatcar"]; ok {
-		if vv, ok := v.([]interface{}); ok {
-			obj.Flatcar = metakubeNodeDeploymentExpandFlatcar(vv)
+	att["backups"] = in.Backups
+
+	att["ipv6"] = in.IPV6
+
+	att["monitoring"] = in.Monitoring
+
+	if l := len(in.Tags); l > 0 {
+		t := make([]interface{}, l)
+		for i, v := range in.Tags {
+			t[i] = v
 		}
+		att["tags"] = t
 	}
 
-	return obj
+	return []interface{}{att}
 }
 
-func metakubeNodeDeploymentExpandUbuntu(p []interface{}) *models.UbuntuSpec {
-	if len(p) < 1 {
-		return nil
+func metakubeNodeDeploymentFlattenAnexiaSpec(in *models.AnexiaNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
 	}
-	obj := &models.UbuntuSpec{}
-	if p[0] == nil {
-		return obj
+
+	att := make(map[string]interface{})
+
+	if in.VlanID != nil {
+		att["vlan_id"] = *in.VlanID
 	}
 
-	in, ok := p[0].(map[string]interface{})
-	if !ok {
-		return obj
+	if in.TemplateID != nil {
+		att["template_id"] = *in.TemplateID
 	}
 
-	if v, ok := in["dist_upgrade_on_boot"]; ok {
-		if vv, ok := v.(bool); ok {
-			obj.DistUpgradeOnBoot = vv
-		}
+	if in.CPUs != nil {
+		att["cpus"] = *in.CPUs
 	}
 
-	return obj
+	if in.Memory != nil {
+		att["memory"] = *in.Memory
+	}
+
+	if in.DiskSize != nil {
+		att["disk_size"] = *in.DiskSize
+	}
+
+	return []interface{}{att}
 }
 
-func metakubeNodeDeploymentExpandFlatcar(p []interface{}) *models.FlatcarSpec {
-	if len(p) < 1 {
-		return nil
+func metakubeNodeDeploymentFlattenKubevirtSpec(in *models.KubevirtNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
 	}
-	obj := &models.FlatcarSpec{}
-	if p[0] == nil {
-		return obj
+
+	att := make(map[string]interface{})
+
+	if in.CPUs != nil {
+		att["cpus"] = *in.CPUs
 	}
 
-	in, ok := p[0].(map[string]interface{})
-	if !ok {
-		return obj
+	if in.Memory != nil {
+		att["memory"] = *in.Memory
 	}
 
-	if v, ok := in["disable_auto_update"]; ok {
-		if vv, ok := v.(bool); ok {
-			obj.DisableAutoUpdate = vv
-		}
+	if in.Namespace != nil {
+		att["namespace"] = *in.Namespace
 	}
 
-	return obj
-}
+	if in.PVCSize != nil {
+		att["pvc_size"] = *in.PVCSize
+	}
 
-func metakubeNodeDeploymentExpandVersion(p []interface{}) *models.NodeVersionInfo {
-	if len(p) < 1 {
-		return nil
+	if in.StorageClassName != nil {
+		att["storage_class_name"] = *in.StorageClassName
 	}
-	if p[0] == nil {
+
+	if in.SourceURL != nil {
+		att["source_url"] = *in.SourceURL
+	}
+
+	return []interface{}{att}
+}
+
+func metakubeNodeDeploymentFlattenEquinixMetalSpec(in *models.PacketNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.InstanceType != nil {
+		att["plan"] = *in.InstanceType
+	}
+
+	if l := len(in.Tags); l > 0 {
+		t := make([]interface{}, l)
+		for i, v := range in.Tags {
+			t[i] = v
+		}
+		att["tags"] = t
+	}
+
+	return []interface{}{att}
+}
+
+func metakubeNodeDeploymentFlattenAlibabaSpec(in *models.AlibabaNodeSpec) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	att := make(map[string]interface{})
+
+	if in.InstanceType != "" {
+		att["instance_type"] = in.InstanceType
+	}
+
+	if in.DiskSize != "" {
+		if v, err := strconv.Atoi(in.DiskSize); err == nil {
+			att["disk_size"] = v
+		}
+	}
+
+	if in.DiskType != "" {
+		att["disk_type"] = in.DiskType
+	}
+
+	if in.VSwitchID != "" {
+		att["vswitch_id"] = in.VSwitchID
+	}
+
+	if in.ZoneID != "" {
+		att["zone_id"] = in.ZoneID
+	}
+
+	if in.InternetMaxBandwidthOut != "" {
+		if v, err := strconv.Atoi(in.InternetMaxBandwidthOut); err == nil {
+			att["internet_max_bandwidth_out"] = v
+		}
+	}
+
+	if l := len(in.Labels); l > 0 {
+		t := make(map[string]string, l)
+		for key, val := range in.Labels {
+			t[key] = val
+		}
+		att["labels"] = t
+	}
+
+	return []interface{}{att}
+}
+
+// expanders
+
+func metakubeNodeDeploymentExpandSpec(p []interface{}) *models.NodeDeploymentSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.NodeDeploymentSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["min_replicas"]; ok {
+		if vv, ok := v.(int); ok {
+			obj.MinReplicas = int32(vv)
+		}
+	}
+
+	if v, ok := in["max_replicas"]; ok {
+		if vv, ok := v.(int); ok {
+			obj.MaxReplicas = int32(vv)
+		}
+	}
+
+	if v, ok := in["replicas"]; ok {
+		if vv, ok := v.(int); ok {
+			obj.Replicas = int32ToPtr(int32(vv))
+		}
+	}
+
+	if v, ok := in["template"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Template = metakubeNodeDeploymentExpandNodeSpec(vv)
+		}
+	}
+
+	if v, ok := in["dynamic_config"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.DynamicConfig = vv
+		}
+	}
+
+	if v, ok := in["paused"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.Paused = vv
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandNodeSpec(p []interface{}) *models.NodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.NodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["labels"]; ok {
+		obj.Labels = make(map[string]string)
+		if vv, ok := v.(map[string]interface{}); ok {
+			for key, val := range vv {
+				if s, ok := val.(string); ok && s != "" {
+					obj.Labels[key] = s
+				}
+			}
+		}
+	}
+
+	if v, ok := in["operating_system"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.OperatingSystem = metakubeNodeDeploymentExpandOS(vv)
+		}
+	}
+
+	if v, ok := in["versions"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Versions = metakubeNodeDeploymentExpandVersion(vv)
+		}
+	}
+
+	if v, ok := in["taints"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			for _, t := range vv {
+				if tt, ok := t.(map[string]interface{}); ok {
+					obj.Taints = append(obj.Taints, metakubeNodeDeploymentExpandTaintSpec(tt))
+				}
+			}
+		}
+	}
+
+	if v, ok := in["cloud"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Cloud = metakubeNodeDeploymentExpandCloudSpec(vv)
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandOS(p []interface{}) *models.OperatingSystemSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.OperatingSystemSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["ubuntu"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Ubuntu = metakubeNodeDeploymentExpandUbuntu(vv)
+		}
+	}
+
+	if v, ok := in["flatcar"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Flatcar = metakubeNodeDeploymentExpandFlatcar(vv)
+		}
+	}
+
+	if v, ok := in["rhel"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Rhel = metakubeNodeDeploymentExpandRHEL(vv)
+		}
+	}
+
+	if v, ok := in["sles"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Sles = metakubeNodeDeploymentExpandSLES(vv)
+		}
+	}
+
+	if v, ok := in["centos"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Centos = metakubeNodeDeploymentExpandCentOS(vv)
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandUbuntu(p []interface{}) *models.UbuntuSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.UbuntuSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["dist_upgrade_on_boot"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.DistUpgradeOnBoot = vv
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandFlatcar(p []interface{}) *models.FlatcarSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.FlatcarSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["disable_auto_update"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.DisableAutoUpdate = vv
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandRHEL(p []interface{}) *models.RHELSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.RHELSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["dist_upgrade_on_boot"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.DistUpgradeOnBoot = vv
+		}
+	}
+
+	if v, ok := in["subscription_manager_user"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.RHELSubscriptionManagerUser = vv
+		}
+	}
+
+	if v, ok := in["subscription_manager_password"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.RHELSubscriptionManagerPassword = vv
+		}
+	}
+
+	if v, ok := in["offline_token"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.RHSMOfflineToken = vv
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandSLES(p []interface{}) *models.SLESSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.SLESSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["dist_upgrade_on_boot"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.DistUpgradeOnBoot = vv
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandCentOS(p []interface{}) *models.CentOSSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.CentOSSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["dist_upgrade_on_boot"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.DistUpgradeOnBoot = vv
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandVersion(p []interface{}) *models.NodeVersionInfo {
+	if len(p) < 1 {
+		return nil
+	}
+	if p[0] == nil {
+		return nil
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	v, ok := in["kubelet"]
+	if !ok {
+		return nil
+	}
+
+	if vv, ok := v.(string); ok && vv != "" {
+		return &models.NodeVersionInfo{Kubelet: vv}
+	}
+	return nil
+}
+
+func metakubeNodeDeploymentExpandTaintSpec(in map[string]interface{}) *models.TaintSpec {
+	obj := &models.TaintSpec{}
+
+	if v, ok := in["key"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.Key = vv
+		}
+	}
+
+	if v, ok := in["value"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.Value = vv
+		}
+	}
+
+	if v, ok := in["effect"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.Effect = vv
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandCloudSpec(p []interface{}) *models.NodeCloudSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.NodeCloudSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["aws"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Aws = metakubeNodeDeploymentExpandAWSSpec(vv)
+		}
+	}
+
+	if v, ok := in["openstack"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Openstack = metakubeNodeDeploymentExpandOpenstackSpec(vv)
+		}
+	}
+
+	if v, ok := in["azure"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Azure = metakubeNodeDeploymentExpandAzureSpec(vv)
+		}
+	}
+
+	if v, ok := in["gcp"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Gcp = metakubeNodeDeploymentExpandGCPSpec(vv)
+		}
+	}
+
+	if v, ok := in["vsphere"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Vsphere = metakubeNodeDeploymentExpandVSphereSpec(vv)
+		}
+	}
+
+	if v, ok := in["hetzner"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Hetzner = metakubeNodeDeploymentExpandHetznerSpec(vv)
+		}
+	}
+
+	if v, ok := in["digitalocean"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Digitalocean = metakubeNodeDeploymentExpandDigitaloceanSpec(vv)
+		}
+	}
+
+	if v, ok := in["anexia"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Anexia = metakubeNodeDeploymentExpandAnexiaSpec(vv)
+		}
+	}
+
+	if v, ok := in["kubevirt"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Kubevirt = metakubeNodeDeploymentExpandKubevirtSpec(vv)
+		}
+	}
+
+	if v, ok := in["equinix_metal"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Packet = metakubeNodeDeploymentExpandEquinixMetalSpec(vv)
+		}
+	}
+
+	if v, ok := in["alibaba"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			obj.Alibaba = metakubeNodeDeploymentExpandAlibabaSpec(vv)
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandAWSSpec(p []interface{}) *models.AWSNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.AWSNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["instance_type"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.InstanceType = strToPtr(vv)
+		}
+	}
+
+	if v, ok := in["disk_size"]; ok {
+		if vv, ok := v.(int); ok {
+			obj.VolumeSize = int64ToPtr(vv)
+		}
+	}
+
+	if v, ok := in["volume_type"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.VolumeType = strToPtr(vv)
+		}
+	}
+
+	if v, ok := in["availability_zone"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.AvailabilityZone = vv
+		}
+	}
+
+	if v, ok := in["subnet_id"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.SubnetID = vv
+		}
+	}
+
+	if v, ok := in["assign_public_ip"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.AssignPublicIP = vv
+		}
+	}
+
+	if v, ok := in["ami"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.AMI = vv
+		}
+	}
+
+	if v, ok := in["tags"]; ok {
+		obj.Tags = make(map[string]string)
+		if vv, ok := v.(map[string]interface{}); ok {
+			for key, val := range vv {
+				if s, ok := val.(string); ok && s != "" {
+					obj.Tags[key] = s
+				}
+			}
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandOpenstackSpec(p []interface{}) *models.OpenstackNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.OpenstackNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["flavor"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.Flavor = strToPtr(vv)
+		}
+	}
+
+	if v, ok := in["image"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.Image = strToPtr(vv)
+		}
+	}
+
+	if v, ok := in["use_floating_ip"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.UseFloatingIP = vv
+		}
+	}
+
+	if v, ok := in["instance_ready_check_period"]; ok {
+		if vv, ok := v.(string); ok {
+			obj.InstanceReadyCheckPeriod = vv
+		}
+	}
+
+	if v, ok := in["instance_ready_check_timeout"]; ok {
+		if vv, ok := v.(string); ok {
+			obj.InstanceReadyCheckTimeout = vv
+		}
+	}
+
+	if v, ok := in["tags"]; ok {
+		obj.Tags = make(map[string]string)
+		for key, val := range v.(map[string]interface{}) {
+			if s, ok := val.(string); ok && s != "" {
+				obj.Tags[key] = s
+			}
+		}
+	}
+
+	if v, ok := in["disk_size"]; ok {
+		if vv, ok := v.(int); ok {
+			obj.RootDiskSizeGB = int64(vv)
+		}
+	}
+
+	if v, ok := in["availability_zone"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.AvailabilityZone = vv
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandAzureSpec(p []interface{}) *models.AzureNodeSpec {
+	if len(p) < 1 {
 		return nil
 	}
 
-	in, ok := p[0].(map[string]interface{})
-	if !ok {
+	obj := &models.AzureNodeSpec{}
+
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["image_id"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.ImageID = vv
+		}
+	}
+
+	if v, ok := in["size"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.Size = strToPtr(vv)
+		}
+	}
+
+	if v, ok := in["assign_public_ip"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.AssignPublicIP = vv
+		}
+	}
+
+	if v, ok := in["disk_size_gb"]; ok {
+		if vv, ok := v.(int32); ok {
+			obj.DataDiskSize = vv
+		}
+	}
+
+	if v, ok := in["os_disk_size_gb"]; ok {
+		if vv, ok := v.(int32); ok {
+			obj.OSDiskSize = vv
+		}
+	}
+
+	if v, ok := in["tags"]; ok {
+		if vv, ok := v.(map[string]string); ok {
+			obj.Tags = vv
+		}
+	}
+
+	if v, ok := in["zones"]; ok {
+		if vv, ok := v.(*schema.Set); ok {
+			for _, z := range vv.List() {
+				if zz, ok := z.(string); ok && zz != "" {
+					obj.Zones = append(obj.Zones, zz)
+				}
+			}
+		}
+	}
+
+	return obj
+}
+
+func metakubeNodeDeploymentExpandGCPSpec(p []interface{}) *models.GCPNodeSpec {
+	if len(p) < 1 {
 		return nil
 	}
+	obj := &models.GCPNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
 
-	v, ok := in["kubelet"]
+	in, ok := p[0].(map[string]interface{})
 	if !ok {
-		return nil
+		return obj
 	}
 
-	if vv, ok := v.(string); ok && vv != "" {
-		return &models.NodeVersionInfo{Kubelet: vv}
+	if v, ok := in["machine_type"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.MachineType = vv
+		}
 	}
-	return nil
-}
 
-func metakubeNodeDeploymentExpandTaintSpec(in map[string]interface{}) *models.TaintSpec {
-	obj := &models.TaintSpec{}
+	if v, ok := in["disk_size"]; ok {
+		if vv, ok := v.(int); ok {
+			obj.DiskSize = int64(vv)
+		}
+	}
 
-	if v, ok := in["key"]; ok {
+	if v, ok := in["disk_type"]; ok {
 		if vv, ok := v.(string); ok && vv != "" {
-			obj.Key = vv
+			obj.DiskType = vv
 		}
 	}
 
-	if v, ok := in["value"]; ok {
+	if v, ok := in["zone"]; ok {
 		if vv, ok := v.(string); ok && vv != "" {
-			obj.Value = vv
+			obj.Zone = vv
 		}
 	}
 
-	if v, ok := in["effect"]; ok {
+	if v, ok := in["preemptible"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.Preemptible = vv
+		}
+	}
+
+	if v, ok := in["custom_image"]; ok {
 		if vv, ok := v.(string); ok && vv != "" {
-			obj.Effect = vv
+			obj.CustomImage = vv
+		}
+	}
+
+	if v, ok := in["labels"]; ok {
+		obj.Labels = make(map[string]string)
+		if vv, ok := v.(map[string]interface{}); ok {
+			for key, val := range vv {
+				if s, ok := val.(string); ok && s != "" {
+					obj.Labels[key] = s
+				}
+			}
+		}
+	}
+
+	if v, ok := in["tags"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			for _, t := range vv {
+				if s, ok := t.(string); ok && s != "" {
+					obj.Tags = append(obj.Tags, s)
+				}
+			}
 		}
 	}
 
 	return obj
 }
 
-func metakubeNodeDeploymentExpandCloudSpec(p []interface{}) *models.NodeCloudSpec {
+func metakubeNodeDeploymentExpandVSphereSpec(p []interface{}) *models.VSphereNodeSpec {
 	if len(p) < 1 {
 		return nil
 	}
-	obj := &models.NodeCloudSpec{}
+	obj := &models.VSphereNodeSpec{}
 	if p[0] == nil {
 		return obj
 	}
@@ -525,32 +1365,38 @@ func metakubeNodeDeploymentExpandCloudSpec(p []interface{}) *models.NodeCloudSpe
 		return obj
 	}
 
-	if v, ok := in["aws"]; ok {
-		if vv, ok := v.([]interface{}); ok {
-			obj.Aws = metakubeNodeDeploymentExpandAWSSpec(vv)
+	if v, ok := in["cpus"]; ok {
+		if vv, ok := v.(int); ok {
+			obj.CPUs = int64(vv)
 		}
 	}
 
-	if v, ok := in["openstack"]; ok {
-		if vv, ok := v.([]interface{}); ok {
-			obj.Openstack = metakubeNodeDeploymentExpandOpenstackSpec(vv)
+	if v, ok := in["memory"]; ok {
+		if vv, ok := v.(int); ok {
+			obj.Memory = int64(vv)
 		}
 	}
 
-	if v, ok := in["azure"]; ok {
-		if vv, ok := v.([]interface{}); ok {
-			obj.Azure = metakubeNodeDeploymentExpandAzureSpec(vv)
+	if v, ok := in["disk_size_gb"]; ok {
+		if vv, ok := v.(int); ok {
+			obj.DiskSizeGB = int64(vv)
+		}
+	}
+
+	if v, ok := in["template"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.Template = vv
 		}
 	}
 
 	return obj
 }
 
-func metakubeNodeDeploymentExpandAWSSpec(p []interface{}) *models.AWSNodeSpec {
+func metakubeNodeDeploymentExpandHetznerSpec(p []interface{}) *models.HetznerNodeSpec {
 	if len(p) < 1 {
 		return nil
 	}
-	obj := &models.AWSNodeSpec{}
+	obj := &models.HetznerNodeSpec{}
 	if p[0] == nil {
 		return obj
 	}
@@ -560,54 +1406,64 @@ func metakubeNodeDeploymentExpandAWSSpec(p []interface{}) *models.AWSNodeSpec {
 		return obj
 	}
 
-	if v, ok := in["instance_type"]; ok {
+	if v, ok := in["type"]; ok {
 		if vv, ok := v.(string); ok && vv != "" {
-			obj.InstanceType = strToPtr(vv)
+			obj.Type = strToPtr(vv)
 		}
 	}
 
-	if v, ok := in["disk_size"]; ok {
-		if vv, ok := v.(int); ok {
-			obj.VolumeSize = int64ToPtr(vv)
+	if v, ok := in["network"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.Network = vv
 		}
 	}
 
-	if v, ok := in["volume_type"]; ok {
-		if vv, ok := v.(string); ok && vv != "" {
-			obj.VolumeType = strToPtr(vv)
-		}
+	return obj
+}
+
+func metakubeNodeDeploymentExpandDigitaloceanSpec(p []interface{}) *models.DigitaloceanNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.DigitaloceanNodeSpec{}
+	if p[0] == nil {
+		return obj
 	}
 
-	if v, ok := in["availability_zone"]; ok {
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["size"]; ok {
 		if vv, ok := v.(string); ok && vv != "" {
-			obj.AvailabilityZone = vv
+			obj.Size = strToPtr(vv)
 		}
 	}
 
-	if v, ok := in["subnet_id"]; ok {
-		if vv, ok := v.(string); ok && vv != "" {
-			obj.SubnetID = vv
+	if v, ok := in["backups"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.Backups = vv
 		}
 	}
 
-	if v, ok := in["assign_public_ip"]; ok {
+	if v, ok := in["ipv6"]; ok {
 		if vv, ok := v.(bool); ok {
-			obj.AssignPublicIP = vv
+			obj.IPV6 = vv
 		}
 	}
 
-	if v, ok := in["ami"]; ok {
-		if vv, ok := v.(string); ok && vv != "" {
-			obj.AMI = vv
+	if v, ok := in["monitoring"]; ok {
+		if vv, ok := v.(bool); ok {
+			obj.Monitoring = vv
 		}
 	}
 
 	if v, ok := in["tags"]; ok {
-		obj.Tags = make(map[string]string)
-		if vv, ok := v.(map[string]interface{}); ok {
-			for key, val := range vv {
-				if s, ok := val.(string); ok && s != "" {
-					obj.Tags[key] = s
+		if vv, ok := v.([]interface{}); ok {
+			for _, t := range vv {
+				if s, ok := t.(string); ok && s != "" {
+					obj.Tags = append(obj.Tags, s)
 				}
 			}
 		}
@@ -616,11 +1472,11 @@ func metakubeNodeDeploymentExpandAWSSpec(p []interface{}) *models.AWSNodeSpec {
 	return obj
 }
 
-func metakubeNodeDeploymentExpandOpenstackSpec(p []interface{}) *models.OpenstackNodeSpec {
+func metakubeNodeDeploymentExpandAnexiaSpec(p []interface{}) *models.AnexiaNodeSpec {
 	if len(p) < 1 {
 		return nil
 	}
-	obj := &models.OpenstackNodeSpec{}
+	obj := &models.AnexiaNodeSpec{}
 	if p[0] == nil {
 		return obj
 	}
@@ -630,61 +1486,130 @@ func metakubeNodeDeploymentExpandOpenstackSpec(p []interface{}) *models.Openstac
 		return obj
 	}
 
-	if v, ok := in["flavor"]; ok {
+	if v, ok := in["vlan_id"]; ok {
 		if vv, ok := v.(string); ok && vv != "" {
-			obj.Flavor = strToPtr(vv)
+			obj.VlanID = strToPtr(vv)
 		}
 	}
 
-	if v, ok := in["image"]; ok {
+	if v, ok := in["template_id"]; ok {
 		if vv, ok := v.(string); ok && vv != "" {
-			obj.Image = strToPtr(vv)
+			obj.TemplateID = strToPtr(vv)
 		}
 	}
 
-	if v, ok := in["use_floating_ip"]; ok {
-		if vv, ok := v.(bool); ok {
-			obj.UseFloatingIP = vv
+	if v, ok := in["cpus"]; ok {
+		if vv, ok := v.(int); ok && vv != 0 {
+			obj.CPUs = int64ToPtr(vv)
 		}
 	}
 
-	if v, ok := in["instance_ready_check_period"]; ok {
-		if vv, ok := v.(string); ok {
-			obj.InstanceReadyCheckPeriod = vv
+	if v, ok := in["memory"]; ok {
+		if vv, ok := v.(int); ok && vv != 0 {
+			obj.Memory = int64ToPtr(vv)
 		}
 	}
 
-	if v, ok := in["instance_ready_check_timeout"]; ok {
-		if vv, ok := v.(string); ok {
-			obj.InstanceReadyCheckTimeout = vv
+	if v, ok := in["disk_size"]; ok {
+		if vv, ok := v.(int); ok && vv != 0 {
+			obj.DiskSize = int64ToPtr(vv)
 		}
 	}
 
-	if v, ok := in["tags"]; ok {
-		obj.Tags = make(map[string]string)
-		for key, val := range v.(map[string]interface{}) {
-			if s, ok := val.(string); ok && s != "" {
-				obj.Tags[key] = s
-			}
+	return obj
+}
+
+func metakubeNodeDeploymentExpandKubevirtSpec(p []interface{}) *models.KubevirtNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.KubevirtNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
+
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["cpus"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.CPUs = strToPtr(vv)
 		}
 	}
 
-	if v, ok := in["disk_size"]; ok {
-		if vv, ok := v.(int); ok {
-			obj.RootDiskSizeGB = int64(vv)
+	if v, ok := in["memory"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.Memory = strToPtr(vv)
+		}
+	}
+
+	if v, ok := in["namespace"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.Namespace = strToPtr(vv)
+		}
+	}
+
+	if v, ok := in["pvc_size"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.PVCSize = strToPtr(vv)
+		}
+	}
+
+	if v, ok := in["storage_class_name"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.StorageClassName = strToPtr(vv)
+		}
+	}
+
+	if v, ok := in["source_url"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.SourceURL = strToPtr(vv)
 		}
 	}
 
 	return obj
 }
 
-func metakubeNodeDeploymentExpandAzureSpec(p []interface{}) *models.AzureNodeSpec {
+func metakubeNodeDeploymentExpandEquinixMetalSpec(p []interface{}) *models.PacketNodeSpec {
 	if len(p) < 1 {
 		return nil
 	}
+	obj := &models.PacketNodeSpec{}
+	if p[0] == nil {
+		return obj
+	}
 
-	obj := &models.AzureNodeSpec{}
+	in, ok := p[0].(map[string]interface{})
+	if !ok {
+		return obj
+	}
+
+	if v, ok := in["plan"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.InstanceType = strToPtr(vv)
+		}
+	}
+
+	if v, ok := in["tags"]; ok {
+		if vv, ok := v.([]interface{}); ok {
+			for _, t := range vv {
+				if s, ok := t.(string); ok && s != "" {
+					obj.Tags = append(obj.Tags, s)
+				}
+			}
+		}
+	}
+
+	return obj
+}
 
+func metakubeNodeDeploymentExpandAlibabaSpec(p []interface{}) *models.AlibabaNodeSpec {
+	if len(p) < 1 {
+		return nil
+	}
+	obj := &models.AlibabaNodeSpec{}
 	if p[0] == nil {
 		return obj
 	}
@@ -694,45 +1619,50 @@ func metakubeNodeDeploymentExpandAzureSpec(p []interface{}) *models.AzureNodeSpe
 		return obj
 	}
 
-	if v, ok := in["image_id"]; ok {
+	if v, ok := in["instance_type"]; ok {
 		if vv, ok := v.(string); ok && vv != "" {
-			obj.ImageID = vv
+			obj.InstanceType = vv
 		}
 	}
 
-	if v, ok := in["size"]; ok {
-		if vv, ok := v.(string); ok && vv != "" {
-			obj.Size = strToPtr(vv)
+	if v, ok := in["disk_size"]; ok {
+		if vv, ok := v.(int); ok && vv != 0 {
+			obj.DiskSize = strconv.Itoa(vv)
 		}
 	}
 
-	if v, ok := in["assign_public_ip"]; ok {
-		if vv, ok := v.(bool); ok {
-			obj.AssignPublicIP = vv
+	if v, ok := in["disk_type"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.DiskType = vv
 		}
 	}
 
-	if v, ok := in["disk_size_gb"]; ok {
-		if vv, ok := v.(int32); ok {
-			obj.DataDiskSize = vv
+	if v, ok := in["vswitch_id"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.VSwitchID = vv
 		}
 	}
 
-	if v, ok := in["os_disk_size_gb"]; ok {
-		if vv, ok := v.(int32); ok {
-			obj.OSDiskSize = vv
+	if v, ok := in["zone_id"]; ok {
+		if vv, ok := v.(string); ok && vv != "" {
+			obj.ZoneID = vv
 		}
 	}
 
-	if v, ok := in["tags"]; ok {
-		if vv, ok := v.(map[string]string); ok {
-			obj.Tags = vv
+	if v, ok := in["internet_max_bandwidth_out"]; ok {
+		if vv, ok := v.(int); ok && vv != 0 {
+			obj.InternetMaxBandwidthOut = strconv.Itoa(vv)
 		}
 	}
 
-	if v, ok := in["zones"]; ok {
-		if vv, ok := v.([]string); ok && len(vv) > 0 {
-			obj.Zones = vv
+	if v, ok := in["labels"]; ok {
+		if vv, ok := v.(map[string]interface{}); ok && len(vv) > 0 {
+			obj.Labels = make(map[string]string)
+			for key, val := range vv {
+				if s, ok := val.(string); ok && s != "" {
+					obj.Labels[key] = s
+				}
+			}
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/syseleven/go-metakube/models"
 )
 
@@ -17,19 +18,37 @@ func TestMetakubeNodeDeploymentFlatten(t *testing.T) {
 				Replicas:      int32ToPtr(1),
 				Template:      &models.NodeSpec{},
 				DynamicConfig: true,
+				Paused:        true,
 			},
 			[]interface{}{
 				map[string]interface{}{
 					"replicas":       int32(1),
 					"template":       []interface{}{map[string]interface{}{}},
 					"dynamic_config": true,
+					"paused":         true,
+				},
+			},
+		},
+		{
+			&models.NodeDeploymentSpec{
+				Replicas:    int32ToPtr(0),
+				MinReplicas: 0,
+				MaxReplicas: 3,
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"replicas":       int32(0),
+					"min_replicas":   int32(0),
+					"max_replicas":   int32(3),
+					"dynamic_config": false,
+					"paused":         false,
 				},
 			},
 		},
 		{
 			&models.NodeDeploymentSpec{},
 			[]interface{}{
-				map[string]interface{}{"dynamic_config": false},
+				map[string]interface{}{"dynamic_config": false, "paused": false},
 			},
 		},
 		{
@@ -46,6 +65,45 @@ func TestMetakubeNodeDeploymentFlatten(t *testing.T) {
 	}
 }
 
+func TestMetakubeNodeDeploymentFlattenStatus(t *testing.T) {
+	cases := []struct {
+		Input          *models.MachineDeploymentStatus
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.MachineDeploymentStatus{
+				Replicas:            3,
+				ReadyReplicas:       2,
+				AvailableReplicas:   2,
+				UnavailableReplicas: 1,
+				UpdatedReplicas:     3,
+				ObservedGeneration:  5,
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"replicas":             int32(3),
+					"ready_replicas":       int32(2),
+					"available_replicas":   int32(2),
+					"unavailable_replicas": int32(1),
+					"updated_replicas":     int32(3),
+					"observed_generation":  int64(5),
+				},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentFlattenStatus(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
 func TestMetakubeNodeDeploymentSpecFlatten(t *testing.T) {
 	cases := []struct {
 		Input          *models.NodeSpec
@@ -67,6 +125,10 @@ func TestMetakubeNodeDeploymentSpecFlatten(t *testing.T) {
 						Value:  "value2",
 						Effect: "NoSchedule",
 					},
+					{
+						Key:    "key3",
+						Effect: "NoExecute",
+					},
 				},
 				Cloud: &models.NodeCloudSpec{
 					Aws: &models.AWSNodeSpec{},
@@ -100,6 +162,11 @@ func TestMetakubeNodeDeploymentSpecFlatten(t *testing.T) {
 							"value":  "value2",
 							"effect": "NoSchedule",
 						},
+						map[string]interface{}{
+							"key":    "key3",
+							"value":  "",
+							"effect": "NoExecute",
+						},
 					},
 					"cloud": []interface{}{
 						map[string]interface{}{
@@ -188,6 +255,60 @@ func TestMetakubeNodeDeploymentFlattenOperatingSystem(t *testing.T) {
 				},
 			},
 		},
+		{
+			&models.OperatingSystemSpec{
+				Rhel: &models.RHELSpec{
+					DistUpgradeOnBoot:               true,
+					RHELSubscriptionManagerUser:     "user",
+					RHELSubscriptionManagerPassword: "password",
+					RHSMOfflineToken:                "token",
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"rhel": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot":          true,
+							"subscription_manager_user":     "user",
+							"subscription_manager_password": "password",
+							"offline_token":                 "token",
+						},
+					},
+				},
+			},
+		},
+		{
+			&models.OperatingSystemSpec{
+				Sles: &models.SLESSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"sles": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+		},
+		{
+			&models.OperatingSystemSpec{
+				Centos: &models.CentOSSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"centos": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+		},
 		{
 			&models.OperatingSystemSpec{},
 			[]interface{}{
@@ -290,7 +411,7 @@ func TestFlattenAzureNodeSpec(t *testing.T) {
 					"tags": map[string]string{
 						"tag-k": "tag-v",
 					},
-					"zones": []string{"Zone-x"},
+					"zones": []interface{}{"Zone-x"},
 				},
 			},
 		},
@@ -333,7 +454,8 @@ func TestFlattenOpenstackNodeSpec(t *testing.T) {
 				Tags: map[string]string{
 					"foo": "bar",
 				},
-				RootDiskSizeGB: int64(999),
+				RootDiskSizeGB:   int64(999),
+				AvailabilityZone: "dbl1",
 			},
 			[]interface{}{
 				map[string]interface{}{
@@ -345,7 +467,8 @@ func TestFlattenOpenstackNodeSpec(t *testing.T) {
 					"tags": map[string]string{
 						"foo": "bar",
 					},
-					"disk_size": int64(999),
+					"disk_size":         int64(999),
+					"availability_zone": "dbl1",
 				},
 			},
 		},
@@ -371,253 +494,688 @@ func TestFlattenOpenstackNodeSpec(t *testing.T) {
 	}
 }
 
-func TestExpandNodeDeploymentSpec(t *testing.T) {
+func TestFlattenGCPNodeSpec(t *testing.T) {
 	cases := []struct {
-		Input          []interface{}
-		ExpectedOutput *models.NodeDeploymentSpec
+		Input          *models.GCPNodeSpec
+		ExpectedOutput []interface{}
 	}{
 		{
+			&models.GCPNodeSpec{
+				MachineType: "n1-standard-2",
+				DiskSize:    25,
+				DiskType:    "pd-ssd",
+				Zone:        "europe-west3-a",
+				Preemptible: true,
+				CustomImage: "CustomImage",
+				Labels: map[string]string{
+					"label-k": "label-v",
+				},
+				Tags: []string{"tag-x"},
+			},
 			[]interface{}{
 				map[string]interface{}{
-					"replicas":       1,
-					"template":       []interface{}{map[string]interface{}{}},
-					"dynamic_config": true,
+					"machine_type": "n1-standard-2",
+					"disk_size":    int64(25),
+					"disk_type":    "pd-ssd",
+					"zone":         "europe-west3-a",
+					"preemptible":  true,
+					"custom_image": "CustomImage",
+					"labels": map[string]string{
+						"label-k": "label-v",
+					},
+					"tags": []interface{}{"tag-x"},
 				},
 			},
-			&models.NodeDeploymentSpec{
-				Replicas:      int32ToPtr(1),
-				Template:      &models.NodeSpec{},
-				DynamicConfig: true,
-			},
 		},
 		{
-
+			&models.GCPNodeSpec{},
 			[]interface{}{
-				map[string]interface{}{},
+				map[string]interface{}{
+					"preemptible": false,
+				},
 			},
-			&models.NodeDeploymentSpec{},
 		},
 		{
-			[]interface{}{},
 			nil,
+			[]interface{}{},
 		},
 	}
 
 	for _, tc := range cases {
-		output := metakubeNodeDeploymentExpandSpec(tc.Input)
+		output := metakubeNodeDeploymentFlattenGCPSpec(tc.Input)
 		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
-			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
 		}
 	}
 }
 
-func TestExpandNodeSpec(t *testing.T) {
+func TestFlattenVSphereNodeSpec(t *testing.T) {
 	cases := []struct {
-		Input          []interface{}
-		ExpectedOutput *models.NodeSpec
+		Input          *models.VSphereNodeSpec
+		ExpectedOutput []interface{}
 	}{
 		{
+			&models.VSphereNodeSpec{
+				CPUs:       2,
+				Memory:     4096,
+				DiskSizeGB: 20,
+				Template:   "ubuntu-template",
+			},
 			[]interface{}{
 				map[string]interface{}{
-					"operating_system": []interface{}{
-						map[string]interface{}{
-							"ubuntu": []interface{}{
-								map[string]interface{}{
-									"dist_upgrade_on_boot": false,
-								},
-							},
-						},
-					},
-					"taints": []interface{}{
-						map[string]interface{}{
-							"key":    "key1",
-							"value":  "value1",
-							"effect": "NoSchedule",
-						},
-						map[string]interface{}{
-							"key":    "key2",
-							"value":  "value2",
-							"effect": "NoSchedule",
-						},
-					},
-					"cloud": []interface{}{
-						map[string]interface{}{
-							"aws": []interface{}{
-								map[string]interface{}{
-									"assign_public_ip": false,
-								},
-							},
-						},
-					},
-					"labels": map[string]interface{}{
-						"foo": "bar",
-					},
-					"versions": []interface{}{
-						map[string]interface{}{
-							"kubelet": "1.18.8",
-						},
-					},
-				},
-			},
-			&models.NodeSpec{
-				OperatingSystem: &models.OperatingSystemSpec{
-					Ubuntu: &models.UbuntuSpec{},
-				},
-				Taints: []*models.TaintSpec{
-					{
-						Key:    "key1",
-						Value:  "value1",
-						Effect: "NoSchedule",
-					},
-					{
-						Key:    "key2",
-						Value:  "value2",
-						Effect: "NoSchedule",
-					},
-				},
-				Cloud: &models.NodeCloudSpec{
-					Aws: &models.AWSNodeSpec{},
-				},
-				Labels: map[string]string{
-					"foo": "bar",
-				},
-				Versions: &models.NodeVersionInfo{
-					Kubelet: "1.18.8",
+					"cpus":         int64(2),
+					"memory":       int64(4096),
+					"disk_size_gb": int64(20),
+					"template":     "ubuntu-template",
 				},
 			},
 		},
 		{
-
+			&models.VSphereNodeSpec{},
 			[]interface{}{
-				map[string]interface{}{
-					"versions": []interface{}{
-						map[string]interface{}{
-							"kubelet": "",
-						},
-					},
-				},
+				map[string]interface{}{},
 			},
-			&models.NodeSpec{},
 		},
 		{
-			[]interface{}{},
 			nil,
+			[]interface{}{},
 		},
 	}
 
 	for _, tc := range cases {
-		output := metakubeNodeDeploymentExpandNodeSpec(tc.Input)
+		output := metakubeNodeDeploymentFlattenVSphereSpec(tc.Input)
 		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
-			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
 		}
 	}
 }
 
-func TestExpandOperatingSystem(t *testing.T) {
+func TestFlattenHetznerNodeSpec(t *testing.T) {
 	cases := []struct {
-		Input          []interface{}
-		ExpectedOutput *models.OperatingSystemSpec
+		Input          *models.HetznerNodeSpec
+		ExpectedOutput []interface{}
 	}{
 		{
-			[]interface{}{
-				map[string]interface{}{
-					"ubuntu": []interface{}{
-						map[string]interface{}{
-							"dist_upgrade_on_boot": true,
-						},
-					},
-				},
-			},
-			&models.OperatingSystemSpec{
-				Ubuntu: &models.UbuntuSpec{
-					DistUpgradeOnBoot: true,
-				},
+			&models.HetznerNodeSpec{
+				Type:    strToPtr("cx21"),
+				Network: "Network",
 			},
-		},
-		{
 			[]interface{}{
 				map[string]interface{}{
-					"flatcar": []interface{}{
-						map[string]interface{}{
-							"disable_auto_update": true,
-						},
-					},
-				},
-			},
-			&models.OperatingSystemSpec{
-				Flatcar: &models.FlatcarSpec{
-					DisableAutoUpdate: true,
+					"type":    "cx21",
+					"network": "Network",
 				},
 			},
 		},
 		{
+			&models.HetznerNodeSpec{},
 			[]interface{}{
 				map[string]interface{}{},
 			},
-			&models.OperatingSystemSpec{},
 		},
 		{
-			[]interface{}{},
 			nil,
+			[]interface{}{},
 		},
 	}
 
 	for _, tc := range cases {
-		output := metakubeNodeDeploymentExpandOS(tc.Input)
+		output := metakubeNodeDeploymentFlattenHetznerSpec(tc.Input)
 		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
-			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
 		}
 	}
 }
 
-func TestExpandAWSNodeSpec(t *testing.T) {
+func TestFlattenDigitaloceanNodeSpec(t *testing.T) {
 	cases := []struct {
-		Input          []interface{}
-		ExpectedOutput *models.AWSNodeSpec
+		Input          *models.DigitaloceanNodeSpec
+		ExpectedOutput []interface{}
 	}{
 		{
+			&models.DigitaloceanNodeSpec{
+				Size:       strToPtr("s-2vcpu-4gb"),
+				Backups:    true,
+				IPV6:       true,
+				Monitoring: true,
+				Tags:       []string{"tag-x"},
+			},
 			[]interface{}{
 				map[string]interface{}{
-					"ami":               "ami-5731123e",
-					"assign_public_ip":  true,
-					"availability_zone": "eu-west-1",
-					"instance_type":     "t3.small",
-					"subnet_id":         "subnet-53485",
-					"tags": map[string]interface{}{
-						"foo": "bar",
-					},
-					"disk_size":   25,
-					"volume_type": "standard",
-				},
-			},
-			&models.AWSNodeSpec{
-				AMI:              "ami-5731123e",
-				AssignPublicIP:   true,
-				AvailabilityZone: "eu-west-1",
-				InstanceType:     strToPtr("t3.small"),
-				SubnetID:         "subnet-53485",
-				Tags: map[string]string{
-					"foo": "bar",
+					"size":       "s-2vcpu-4gb",
+					"backups":    true,
+					"ipv6":       true,
+					"monitoring": true,
+					"tags":       []interface{}{"tag-x"},
 				},
-				VolumeSize: int64ToPtr(25),
-				VolumeType: strToPtr("standard"),
 			},
 		},
 		{
-
+			&models.DigitaloceanNodeSpec{},
 			[]interface{}{
-				map[string]interface{}{},
+				map[string]interface{}{
+					"backups":    false,
+					"ipv6":       false,
+					"monitoring": false,
+				},
 			},
-			&models.AWSNodeSpec{},
 		},
 		{
-			[]interface{}{},
 			nil,
+			[]interface{}{},
 		},
 	}
 
 	for _, tc := range cases {
-		output := metakubeNodeDeploymentExpandAWSSpec(tc.Input)
+		output := metakubeNodeDeploymentFlattenDigitaloceanSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenAnexiaNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.AnexiaNodeSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.AnexiaNodeSpec{
+				VlanID:     strToPtr("VlanID"),
+				TemplateID: strToPtr("TemplateID"),
+				CPUs:       int64ToPtr(2),
+				Memory:     int64ToPtr(4096),
+				DiskSize:   int64ToPtr(20),
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"vlan_id":     "VlanID",
+					"template_id": "TemplateID",
+					"cpus":        int64(2),
+					"memory":      int64(4096),
+					"disk_size":   int64(20),
+				},
+			},
+		},
+		{
+			&models.AnexiaNodeSpec{},
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentFlattenAnexiaSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenKubevirtNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.KubevirtNodeSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.KubevirtNodeSpec{
+				CPUs:             strToPtr("2"),
+				Memory:           strToPtr("4Gi"),
+				Namespace:        strToPtr("kube-system"),
+				PVCSize:          strToPtr("20Gi"),
+				StorageClassName: strToPtr("standard"),
+				SourceURL:        strToPtr("http://example.com/image.qcow2"),
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"cpus":               "2",
+					"memory":             "4Gi",
+					"namespace":          "kube-system",
+					"pvc_size":           "20Gi",
+					"storage_class_name": "standard",
+					"source_url":         "http://example.com/image.qcow2",
+				},
+			},
+		},
+		{
+			&models.KubevirtNodeSpec{},
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentFlattenKubevirtSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenEquinixMetalNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.PacketNodeSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.PacketNodeSpec{
+				InstanceType: strToPtr("c3.small.x86"),
+				Tags:         []string{"tag-x"},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"plan": "c3.small.x86",
+					"tags": []interface{}{"tag-x"},
+				},
+			},
+		},
+		{
+			&models.PacketNodeSpec{},
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentFlattenEquinixMetalSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestFlattenAlibabaNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          *models.AlibabaNodeSpec
+		ExpectedOutput []interface{}
+	}{
+		{
+			&models.AlibabaNodeSpec{
+				InstanceType:            "ecs.c6.large",
+				DiskSize:                "40",
+				DiskType:                "cloud_efficiency",
+				VSwitchID:               "VSwitchID",
+				ZoneID:                  "ZoneID",
+				InternetMaxBandwidthOut: "10",
+				Labels: map[string]string{
+					"label-k": "label-v",
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"instance_type":              "ecs.c6.large",
+					"disk_size":                  40,
+					"disk_type":                  "cloud_efficiency",
+					"vswitch_id":                 "VSwitchID",
+					"zone_id":                    "ZoneID",
+					"internet_max_bandwidth_out": 10,
+					"labels": map[string]string{
+						"label-k": "label-v",
+					},
+				},
+			},
+		},
+		{
+			&models.AlibabaNodeSpec{},
+			[]interface{}{
+				map[string]interface{}{},
+			},
+		},
+		{
+			nil,
+			[]interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentFlattenAlibabaSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from flattener: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandNodeDeploymentSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.NodeDeploymentSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"replicas":       1,
+					"template":       []interface{}{map[string]interface{}{}},
+					"dynamic_config": true,
+					"paused":         true,
+				},
+			},
+			&models.NodeDeploymentSpec{
+				Replicas:      int32ToPtr(1),
+				Template:      &models.NodeSpec{},
+				DynamicConfig: true,
+				Paused:        true,
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"replicas":     0,
+					"min_replicas": 0,
+					"max_replicas": 3,
+				},
+			},
+			&models.NodeDeploymentSpec{
+				Replicas:    int32ToPtr(0),
+				MinReplicas: 0,
+				MaxReplicas: 3,
+			},
+		},
+		{
+
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.NodeDeploymentSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.NodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"operating_system": []interface{}{
+						map[string]interface{}{
+							"ubuntu": []interface{}{
+								map[string]interface{}{
+									"dist_upgrade_on_boot": false,
+								},
+							},
+						},
+					},
+					"taints": []interface{}{
+						map[string]interface{}{
+							"key":    "key1",
+							"value":  "value1",
+							"effect": "NoSchedule",
+						},
+						map[string]interface{}{
+							"key":    "key2",
+							"value":  "value2",
+							"effect": "NoSchedule",
+						},
+						map[string]interface{}{
+							"key":    "key3",
+							"value":  "",
+							"effect": "NoExecute",
+						},
+					},
+					"cloud": []interface{}{
+						map[string]interface{}{
+							"aws": []interface{}{
+								map[string]interface{}{
+									"assign_public_ip": false,
+								},
+							},
+						},
+					},
+					"labels": map[string]interface{}{
+						"foo": "bar",
+					},
+					"versions": []interface{}{
+						map[string]interface{}{
+							"kubelet": "1.18.8",
+						},
+					},
+				},
+			},
+			&models.NodeSpec{
+				OperatingSystem: &models.OperatingSystemSpec{
+					Ubuntu: &models.UbuntuSpec{},
+				},
+				Taints: []*models.TaintSpec{
+					{
+						Key:    "key1",
+						Value:  "value1",
+						Effect: "NoSchedule",
+					},
+					{
+						Key:    "key2",
+						Value:  "value2",
+						Effect: "NoSchedule",
+					},
+					{
+						Key:    "key3",
+						Effect: "NoExecute",
+					},
+				},
+				Cloud: &models.NodeCloudSpec{
+					Aws: &models.AWSNodeSpec{},
+				},
+				Labels: map[string]string{
+					"foo": "bar",
+				},
+				Versions: &models.NodeVersionInfo{
+					Kubelet: "1.18.8",
+				},
+			},
+		},
+		{
+
+			[]interface{}{
+				map[string]interface{}{
+					"versions": []interface{}{
+						map[string]interface{}{
+							"kubelet": "",
+						},
+					},
+				},
+			},
+			&models.NodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandNodeSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandOperatingSystem(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.OperatingSystemSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"ubuntu": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Ubuntu: &models.UbuntuSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"flatcar": []interface{}{
+						map[string]interface{}{
+							"disable_auto_update": true,
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Flatcar: &models.FlatcarSpec{
+					DisableAutoUpdate: true,
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"rhel": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot":          true,
+							"subscription_manager_user":     "user",
+							"subscription_manager_password": "password",
+							"offline_token":                 "token",
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Rhel: &models.RHELSpec{
+					DistUpgradeOnBoot:               true,
+					RHELSubscriptionManagerUser:     "user",
+					RHELSubscriptionManagerPassword: "password",
+					RHSMOfflineToken:                "token",
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"sles": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Sles: &models.SLESSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"centos": []interface{}{
+						map[string]interface{}{
+							"dist_upgrade_on_boot": true,
+						},
+					},
+				},
+			},
+			&models.OperatingSystemSpec{
+				Centos: &models.CentOSSpec{
+					DistUpgradeOnBoot: true,
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.OperatingSystemSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandOS(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandAWSNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.AWSNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"ami":               "ami-5731123e",
+					"assign_public_ip":  true,
+					"availability_zone": "eu-west-1",
+					"instance_type":     "t3.small",
+					"subnet_id":         "subnet-53485",
+					"tags": map[string]interface{}{
+						"foo": "bar",
+					},
+					"disk_size":   25,
+					"volume_type": "standard",
+				},
+			},
+			&models.AWSNodeSpec{
+				AMI:              "ami-5731123e",
+				AssignPublicIP:   true,
+				AvailabilityZone: "eu-west-1",
+				InstanceType:     strToPtr("t3.small"),
+				SubnetID:         "subnet-53485",
+				Tags: map[string]string{
+					"foo": "bar",
+				},
+				VolumeSize: int64ToPtr(25),
+				VolumeType: strToPtr("standard"),
+			},
+		},
+		{
+
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.AWSNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandAWSSpec(tc.Input)
 		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
 			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
 		}
@@ -638,7 +1196,8 @@ func TestExpandOpenstackNodeSpec(t *testing.T) {
 					"tags": map[string]interface{}{
 						"foo": "bar",
 					},
-					"disk_size": 999,
+					"disk_size":         999,
+					"availability_zone": "dbl1",
 				},
 			},
 			&models.OpenstackNodeSpec{
@@ -648,7 +1207,8 @@ func TestExpandOpenstackNodeSpec(t *testing.T) {
 				Tags: map[string]string{
 					"foo": "bar",
 				},
-				RootDiskSizeGB: int64(999),
+				RootDiskSizeGB:   int64(999),
+				AvailabilityZone: "dbl1",
 			},
 		},
 		{
@@ -688,7 +1248,7 @@ func TestExpandAzureNodeSpec(t *testing.T) {
 					"tags": map[string]string{
 						"tag-k": "tag-v",
 					},
-					"zones": []string{"Zone-x"},
+					"zones": schema.NewSet(schema.HashString, []interface{}{"Zone-x"}),
 				},
 			},
 			&models.AzureNodeSpec{
@@ -723,3 +1283,353 @@ func TestExpandAzureNodeSpec(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandGCPNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.GCPNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"machine_type": "n1-standard-2",
+					"disk_size":    25,
+					"disk_type":    "pd-ssd",
+					"zone":         "europe-west3-a",
+					"preemptible":  true,
+					"custom_image": "CustomImage",
+					"labels": map[string]interface{}{
+						"label-k": "label-v",
+					},
+					"tags": []interface{}{"tag-x"},
+				},
+			},
+			&models.GCPNodeSpec{
+				MachineType: "n1-standard-2",
+				DiskSize:    25,
+				DiskType:    "pd-ssd",
+				Zone:        "europe-west3-a",
+				Preemptible: true,
+				CustomImage: "CustomImage",
+				Labels: map[string]string{
+					"label-k": "label-v",
+				},
+				Tags: []string{"tag-x"},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.GCPNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandGCPSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandVSphereNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.VSphereNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"cpus":         2,
+					"memory":       4096,
+					"disk_size_gb": 20,
+					"template":     "ubuntu-template",
+				},
+			},
+			&models.VSphereNodeSpec{
+				CPUs:       2,
+				Memory:     4096,
+				DiskSizeGB: 20,
+				Template:   "ubuntu-template",
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.VSphereNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandVSphereSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandHetznerNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.HetznerNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"type":    "cx21",
+					"network": "Network",
+				},
+			},
+			&models.HetznerNodeSpec{
+				Type:    strToPtr("cx21"),
+				Network: "Network",
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.HetznerNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandHetznerSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandDigitaloceanNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.DigitaloceanNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"size":       "s-2vcpu-4gb",
+					"backups":    true,
+					"ipv6":       true,
+					"monitoring": true,
+					"tags":       []interface{}{"tag-x"},
+				},
+			},
+			&models.DigitaloceanNodeSpec{
+				Size:       strToPtr("s-2vcpu-4gb"),
+				Backups:    true,
+				IPV6:       true,
+				Monitoring: true,
+				Tags:       []string{"tag-x"},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.DigitaloceanNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandDigitaloceanSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandAnexiaNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.AnexiaNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"vlan_id":     "VlanID",
+					"template_id": "TemplateID",
+					"cpus":        2,
+					"memory":      4096,
+					"disk_size":   20,
+				},
+			},
+			&models.AnexiaNodeSpec{
+				VlanID:     strToPtr("VlanID"),
+				TemplateID: strToPtr("TemplateID"),
+				CPUs:       int64ToPtr(2),
+				Memory:     int64ToPtr(4096),
+				DiskSize:   int64ToPtr(20),
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.AnexiaNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandAnexiaSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandKubevirtNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.KubevirtNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"cpus":               "2",
+					"memory":             "4Gi",
+					"namespace":          "kube-system",
+					"pvc_size":           "20Gi",
+					"storage_class_name": "standard",
+					"source_url":         "http://example.com/image.qcow2",
+				},
+			},
+			&models.KubevirtNodeSpec{
+				CPUs:             strToPtr("2"),
+				Memory:           strToPtr("4Gi"),
+				Namespace:        strToPtr("kube-system"),
+				PVCSize:          strToPtr("20Gi"),
+				StorageClassName: strToPtr("standard"),
+				SourceURL:        strToPtr("http://example.com/image.qcow2"),
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.KubevirtNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandKubevirtSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandEquinixMetalNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.PacketNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"plan": "c3.small.x86",
+					"tags": []interface{}{"tag-x"},
+				},
+			},
+			&models.PacketNodeSpec{
+				InstanceType: strToPtr("c3.small.x86"),
+				Tags:         []string{"tag-x"},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.PacketNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandEquinixMetalSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestExpandAlibabaNodeSpec(t *testing.T) {
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *models.AlibabaNodeSpec
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"instance_type":              "ecs.c6.large",
+					"disk_size":                  40,
+					"disk_type":                  "cloud_efficiency",
+					"vswitch_id":                 "VSwitchID",
+					"zone_id":                    "ZoneID",
+					"internet_max_bandwidth_out": 10,
+					"labels": map[string]interface{}{
+						"label-k": "label-v",
+					},
+				},
+			},
+			&models.AlibabaNodeSpec{
+				InstanceType:            "ecs.c6.large",
+				DiskSize:                "40",
+				DiskType:                "cloud_efficiency",
+				VSwitchID:               "VSwitchID",
+				ZoneID:                  "ZoneID",
+				InternetMaxBandwidthOut: "10",
+				Labels: map[string]string{
+					"label-k": "label-v",
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{},
+			},
+			&models.AlibabaNodeSpec{},
+		},
+		{
+			[]interface{}{},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		output := metakubeNodeDeploymentExpandAlibabaSpec(tc.Input)
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"os"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/syseleven/go-metakube/client/project"
@@ -577,3 +580,92 @@ func testAccCheckMetaKubeNodeDeploymentExists(n string, rec *models.NodeDeployme
 		return nil
 	}
 }
+
+func TestMetakubeNodeDeploymentReadyEnough(t *testing.T) {
+	cases := []struct {
+		Replicas   int32
+		Status     *models.MachineDeploymentStatus
+		ReadyRatio float64
+		Expected   bool
+	}{
+		{3, &models.MachineDeploymentStatus{ReadyReplicas: 3}, 1, true},
+		{3, &models.MachineDeploymentStatus{ReadyReplicas: 2, UnavailableReplicas: 1}, 1, false},
+		{3, &models.MachineDeploymentStatus{ReadyReplicas: 3, UnavailableReplicas: 1}, 1, false},
+		{10, &models.MachineDeploymentStatus{ReadyReplicas: 9, UnavailableReplicas: 1}, 0.9, true},
+		{10, &models.MachineDeploymentStatus{ReadyReplicas: 8, UnavailableReplicas: 2}, 0.9, false},
+		{3, &models.MachineDeploymentStatus{ReadyReplicas: 2, UnavailableReplicas: 1}, 0.5, true},
+		{0, &models.MachineDeploymentStatus{}, 1, true},
+		{3, nil, 1, false},
+	}
+
+	for _, tc := range cases {
+		if got := metakubeNodeDeploymentReadyEnough(tc.Replicas, tc.Status, tc.ReadyRatio); got != tc.Expected {
+			t.Errorf("replicas %d, status %+v, ratio %v: want %v, got %v", tc.Replicas, tc.Status, tc.ReadyRatio, tc.Expected, got)
+		}
+	}
+}
+
+func TestMetakubeNodeDeploymentSpecPatch(t *testing.T) {
+	before := &models.NodeDeploymentSpec{
+		Replicas: int32ToPtr(3),
+		Template: &models.NodeSpec{
+			Labels: map[string]string{"a": "1", "b": "2"},
+			Cloud: &models.NodeCloudSpec{
+				Openstack: &models.OpenstackNodeSpec{Flavor: strToPtr("m1.small"), Image: strToPtr("resolved-image")},
+			},
+		},
+	}
+	after := &models.NodeDeploymentSpec{
+		Replicas: int32ToPtr(5),
+		Template: &models.NodeSpec{
+			Labels: map[string]string{"a": "1", "c": "3"},
+			Cloud: &models.NodeCloudSpec{
+				Openstack: &models.OpenstackNodeSpec{Flavor: strToPtr("m1.small"), Image: strToPtr("resolved-image")},
+			},
+		},
+	}
+	want := map[string]interface{}{
+		"replicas": float64(5),
+		"template": map[string]interface{}{
+			"labels": map[string]interface{}{"b": nil, "c": "3"},
+		},
+	}
+
+	got, err := metakubeNodeDeploymentSpecPatch(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected patch: mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err = metakubeNodeDeploymentSpecPatch(after, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("want empty patch for unchanged spec, got %v", got)
+	}
+}
+
+func TestMetakubeNodeDeploymentEventMessages(t *testing.T) {
+	at := func(min int) strfmt.DateTime {
+		return strfmt.DateTime(time.Date(2021, 8, 1, 12, min, 0, 0, time.UTC))
+	}
+	events := []*models.Event{
+		{Message: "flavor not found", LastTimestamp: at(1)},
+		{Message: "quota exceeded", LastTimestamp: at(3)},
+		nil,
+		{Message: "", LastTimestamp: at(4)},
+		{Message: "quota exceeded", LastTimestamp: at(2)},
+		{Message: "image missing", LastTimestamp: at(0)},
+	}
+
+	want := []string{"quota exceeded", "flavor not found"}
+	if diff := cmp.Diff(want, metakubeNodeDeploymentEventMessages(events, 2)); diff != "" {
+		t.Fatalf("unexpected messages: mismatch (-want +got):\n%s", diff)
+	}
+	if got := metakubeNodeDeploymentEventMessages(nil, 2); len(got) != 0 {
+		t.Fatalf("want no messages, got %v", got)
+	}
+}
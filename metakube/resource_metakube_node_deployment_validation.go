@@ -4,7 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/syseleven/go-metakube/client/aws"
+	"github.com/syseleven/go-metakube/client/azure"
+	"github.com/syseleven/go-metakube/client/openstack"
 	"github.com/syseleven/go-metakube/client/project"
 	"github.com/syseleven/go-metakube/models"
 )
@@ -36,6 +41,35 @@ func validateNodeSpecMatchesCluster() schema.CustomizeDiffFunc {
 	}
 }
 
+// trackClusterKubeletVersion plans kubelet upgrade when auto_upgrade_kubelet is set and cluster version changed.
+func trackClusterKubeletVersion() schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || !d.Get("auto_upgrade_kubelet").(bool) {
+			return nil
+		}
+		k := meta.(*metakubeProviderMeta)
+		clusterID := d.Get("cluster_id").(string)
+		projectID := d.Get("project_id").(string)
+		if clusterID == "" || projectID == "" {
+			return nil
+		}
+		cluster, err := metakubeGetCluster(ctx, projectID, clusterID, k)
+		if err != nil {
+			// Cluster may be deleted or unavailable, e.g. during refresh-only plans. Read reports it, there is nothing to track.
+			k.log.Warnf("not tracking cluster version for kubelet: %v", err)
+			return nil
+		}
+		if cluster.Spec == nil {
+			return nil
+		}
+		clusterVersion, ok := cluster.Spec.Version.(string)
+		if !ok || clusterVersion == "" || clusterVersion == d.Get("kubelet_version").(string) {
+			return nil
+		}
+		return d.SetNew("kubelet_version", clusterVersion)
+	}
+}
+
 func getClusterCloudProvider(c *models.Cluster) (string, error) {
 	switch {
 	case c.Spec.Cloud.Bringyourown != nil:
@@ -46,6 +80,22 @@ func getClusterCloudProvider(c *models.Cluster) (string, error) {
 		return "openstack", nil
 	case c.Spec.Cloud.Azure != nil:
 		return "azure", nil
+	case c.Spec.Cloud.Gcp != nil:
+		return "gcp", nil
+	case c.Spec.Cloud.Vsphere != nil:
+		return "vsphere", nil
+	case c.Spec.Cloud.Hetzner != nil:
+		return "hetzner", nil
+	case c.Spec.Cloud.Digitalocean != nil:
+		return "digitalocean", nil
+	case c.Spec.Cloud.Anexia != nil:
+		return "anexia", nil
+	case c.Spec.Cloud.Kubevirt != nil:
+		return "kubevirt", nil
+	case c.Spec.Cloud.Packet != nil:
+		return "equinix_metal", nil
+	case c.Spec.Cloud.Alibaba != nil:
+		return "alibaba", nil
 	default:
 		return "", fmt.Errorf("could not find cloud provider for cluster")
 
@@ -53,7 +103,7 @@ func getClusterCloudProvider(c *models.Cluster) (string, error) {
 }
 
 func validateProviderMatchesCluster(d *schema.ResourceDiff, clusterProvider string) error {
-	var availableProviders = []string{"bringyourown", "aws", "openstack", "azure"}
+	var availableProviders = []string{"bringyourown", "aws", "openstack", "azure", "gcp", "vsphere", "hetzner", "digitalocean", "anexia", "kubevirt", "equinix_metal", "alibaba"}
 	var provider string
 
 	for _, p := range availableProviders {
@@ -86,29 +136,200 @@ func metakubeGetCluster(ctx context.Context, proj, cls string, k *metakubeProvid
 
 func validateAutoscalerFields() schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		// GetOk reports min_replicas = 0 as unset, so max_replicas decides whether autoscaler is configured.
 		minReplicas, ok1 := d.GetOk("spec.0.min_replicas")
 		maxReplicas, ok2 := d.GetOk("spec.0.max_replicas")
-		if ok1 && ok1 != ok2 {
+		if ok1 && !ok2 {
 			return fmt.Errorf("to configure autoscaler both min_replicas and max_replicas must be set")
 		}
-		if ok1 == false {
+		if !ok2 {
+			return nil
+		}
+		if !ok1 {
+			minReplicas = 0
+		}
+
+		// Replicas diff is suppressed for autoscaled node deployments, so this is the current number of replicas.
+		replicas := d.Get("spec.0.replicas").(int)
+		minChanged := d.HasChange("spec.0.min_replicas")
+		maxChanged := d.HasChange("spec.0.max_replicas")
+		return validateAutoscalerRange(replicas, minReplicas.(int), maxReplicas.(int), minChanged, maxChanged)
+	}
+}
+
+// validateAutoscalerRange checks replicas fall within [min, max] range. When only one of the bounds is changed
+// the error names it, as that is the one contradicting the rest of the configuration.
+func validateAutoscalerRange(replicas, min, max int, minChanged, maxChanged bool) error {
+	if min > max {
+		switch {
+		case minChanged && !maxChanged:
+			return fmt.Errorf("min_replicas changed to %d, must not be bigger than max_replicas %d", min, max)
+		case maxChanged && !minChanged:
+			return fmt.Errorf("max_replicas changed to %d, must not be smaller than min_replicas %d", max, min)
+		}
+		return fmt.Errorf("min_replicas %d must not be bigger than max_replicas %d", min, max)
+	}
+	if replicas > max {
+		return fmt.Errorf("max_replicas %d can't be smaller than current replicas %d", max, replicas)
+	}
+	if replicas < min {
+		return fmt.Errorf("min_replicas %d can't be bigger than current replicas %d", min, replicas)
+	}
+	return nil
+}
+
+// metakubeResourceNodeDeploymentPreflight checks node deployment cloud spec against resources MetaKube API
+// reports for the cluster, to fail early instead of waiting for machines that will never be provisioned.
+// Checks are skipped if the API can't list the resources.
+func metakubeResourceNodeDeploymentPreflight(ctx context.Context, k *metakubeProviderMeta, projectID, clusterID string, spec *models.NodeDeploymentSpec) diag.Diagnostics {
+	if spec == nil || spec.Template == nil || spec.Template.Cloud == nil {
+		return nil
+	}
+	cloud := spec.Template.Cloud
+
+	switch {
+	case cloud.Openstack != nil:
+		var ret diag.Diagnostics
+		if cloud.Openstack.Flavor != nil {
+			p := openstack.NewListOpenstackSizesNoCredentialsV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(clusterID)
+			r, err := k.client.Openstack.ListOpenstackSizesNoCredentialsV2(p, k.auth)
+			if err != nil {
+				k.log.Debugf("preflight: list openstack sizes: %s", stringifyResponseError(err))
+			} else {
+				ret = append(ret, diagnoseOpenstackFlavor(*cloud.Openstack.Flavor, r.Payload)...)
+			}
+		}
+		if cloud.Openstack.AvailabilityZone != "" {
+			p := openstack.NewListOpenstackAvailabilityZonesNoCredentialsV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(clusterID)
+			r, err := k.client.Openstack.ListOpenstackAvailabilityZonesNoCredentialsV2(p, k.auth)
+			if err != nil {
+				k.log.Debugf("preflight: list openstack availability zones: %s", stringifyResponseError(err))
+			} else {
+				ret = append(ret, diagnoseOpenstackAvailabilityZone(cloud.Openstack.AvailabilityZone, r.Payload)...)
+			}
+		}
+		return ret
+	case cloud.Aws != nil:
+		var ret diag.Diagnostics
+		if cloud.Aws.InstanceType != nil {
+			p := aws.NewListAWSSizesNoCredentialsV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(clusterID)
+			r, err := k.client.Aws.ListAWSSizesNoCredentialsV2(p, k.auth)
+			if err != nil {
+				k.log.Debugf("preflight: list aws sizes: %s", stringifyResponseError(err))
+			} else {
+				ret = append(ret, diagnoseAWSInstanceType(*cloud.Aws.InstanceType, r.Payload)...)
+			}
+		}
+		if cloud.Aws.SubnetID != "" {
+			p := aws.NewListAWSSubnetsNoCredentialsV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(clusterID)
+			r, err := k.client.Aws.ListAWSSubnetsNoCredentialsV2(p, k.auth)
+			if err != nil {
+				k.log.Debugf("preflight: list aws subnets: %s", stringifyResponseError(err))
+			} else {
+				ret = append(ret, diagnoseAWSSubnet(cloud.Aws.SubnetID, cloud.Aws.AvailabilityZone, r.Payload)...)
+			}
+		}
+		return ret
+	case cloud.Azure != nil && cloud.Azure.Size != nil:
+		p := azure.NewListAzureSizesNoCredentialsV2Params().WithContext(ctx).WithProjectID(projectID).WithClusterID(clusterID)
+		r, err := k.client.Azure.ListAzureSizesNoCredentialsV2(p, k.auth)
+		if err != nil {
+			k.log.Debugf("preflight: list azure sizes: %s", stringifyResponseError(err))
+			return nil
+		}
+		return diagnoseAzureSize(*cloud.Azure.Size, r.Payload)
+	}
+	return nil
+}
+
+func nodeDeploymentCloudAttrPath(provider, field string) cty.Path {
+	return cty.GetAttrPath("spec").IndexInt(0).GetAttr("template").IndexInt(0).GetAttr("cloud").IndexInt(0).GetAttr(provider).IndexInt(0).GetAttr(field)
+}
+
+func diagnoseOpenstackFlavor(flavor string, sizes []*models.OpenstackSize) diag.Diagnostics {
+	var available []string
+	for _, v := range sizes {
+		if v.Slug == flavor {
 			return nil
 		}
+		available = append(available, v.Slug)
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("unknown flavor %s", flavor),
+		AttributePath: nodeDeploymentCloudAttrPath("openstack", "flavor"),
+		Detail:        fmt.Sprintf("Please select one of available flavors: %v", available),
+	}}
+}
 
-		if minReplicas.(int) > maxReplicas.(int) {
-			return fmt.Errorf("min_replicas must be smaller than max_replicas")
+func diagnoseOpenstackAvailabilityZone(zone string, zones []*models.OpenstackAvailabilityZone) diag.Diagnostics {
+	var available []string
+	for _, v := range zones {
+		if v.Name == zone {
+			return nil
 		}
+		available = append(available, v.Name)
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("unknown availability zone %s", zone),
+		AttributePath: nodeDeploymentCloudAttrPath("openstack", "availability_zone"),
+		Detail:        fmt.Sprintf("Please select one of available availability zones: %v", available),
+	}}
+}
 
-		replicas := 1
-		if v, ok := d.GetOk("spec.0.replicas"); ok {
-			replicas = v.(int)
+func diagnoseAWSInstanceType(instanceType string, sizes models.AWSSizeList) diag.Diagnostics {
+	var available []string
+	for _, v := range sizes {
+		if v.Name == instanceType {
+			return nil
 		}
-		if replicas > maxReplicas.(int) {
-			return fmt.Errorf("max_replicas can't be smaller than replicas")
+		available = append(available, v.Name)
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("unknown instance type %s", instanceType),
+		AttributePath: nodeDeploymentCloudAttrPath("aws", "instance_type"),
+		Detail:        fmt.Sprintf("Please select one of available instance types: %v", available),
+	}}
+}
+
+func diagnoseAWSSubnet(subnetID, availabilityZone string, subnets models.AWSSubnetList) diag.Diagnostics {
+	var available []string
+	for _, v := range subnets {
+		if v.ID != subnetID {
+			available = append(available, fmt.Sprintf("%s/%s", v.ID, v.AvailabilityZone))
+			continue
 		}
-		if replicas < minReplicas.(int) {
-			return fmt.Errorf("min_replicas can't be bigger than replicas")
+		if availabilityZone != "" && v.AvailabilityZone != availabilityZone {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("subnet %s is in availability zone %s", subnetID, v.AvailabilityZone),
+				AttributePath: nodeDeploymentCloudAttrPath("aws", "availability_zone"),
+			}}
 		}
 		return nil
 	}
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("unknown subnet %s", subnetID),
+		AttributePath: nodeDeploymentCloudAttrPath("aws", "subnet_id"),
+		Detail:        fmt.Sprintf("Please select one of available subnets (id/availability zone): %v", available),
+	}}
+}
+
+func diagnoseAzureSize(size string, sizes models.AzureSizeList) diag.Diagnostics {
+	var available []string
+	for _, v := range sizes {
+		if v.Name == size {
+			return nil
+		}
+		available = append(available, v.Name)
+	}
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("unknown VM size %s", size),
+		AttributePath: nodeDeploymentCloudAttrPath("azure", "size"),
+		Detail:        fmt.Sprintf("Please select one of available sizes: %v", available),
+	}}
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/syseleven/go-metakube/models"
 )
 
 func TestAccMetakubeNodeDeployment_ValidationAgainstCluster(t *testing.T) {
@@ -149,3 +150,92 @@ func testAccCheckMetaKubeNodeDeploymentTypeValidation(n, keyID, keySecret, vpcID
 		}
 	}`, n, n, nodeDC, k8sVersion, keyID, keySecret, vpcID, n, kubeletVersion)
 }
+
+func TestDiagnoseOpenstackFlavor(t *testing.T) {
+	sizes := []*models.OpenstackSize{{Slug: "m1.small"}, {Slug: "m1.medium"}}
+	if diags := diagnoseOpenstackFlavor("m1.small", sizes); diags != nil {
+		t.Fatalf("unexpected diagnostics for known flavor: %v", diags)
+	}
+	diags := diagnoseOpenstackFlavor("m1.unknown", sizes)
+	if len(diags) != 1 || !diags.HasError() {
+		t.Fatalf("expected an error for unknown flavor, got: %v", diags)
+	}
+	if want := nodeDeploymentCloudAttrPath("openstack", "flavor"); !diags[0].AttributePath.Equals(want) {
+		t.Fatalf("unexpected attribute path: %v", diags[0].AttributePath)
+	}
+}
+
+func TestDiagnoseOpenstackAvailabilityZone(t *testing.T) {
+	zones := []*models.OpenstackAvailabilityZone{{Name: "dbl1"}, {Name: "cbk1"}}
+	if diags := diagnoseOpenstackAvailabilityZone("dbl1", zones); diags != nil {
+		t.Fatalf("unexpected diagnostics for known availability zone: %v", diags)
+	}
+	diags := diagnoseOpenstackAvailabilityZone("fes1", zones)
+	if len(diags) != 1 || !diags.HasError() {
+		t.Fatalf("expected an error for unknown availability zone, got: %v", diags)
+	}
+	if want := nodeDeploymentCloudAttrPath("openstack", "availability_zone"); !diags[0].AttributePath.Equals(want) {
+		t.Fatalf("unexpected attribute path: %v", diags[0].AttributePath)
+	}
+}
+
+func TestValidateAutoscalerRange(t *testing.T) {
+	cases := []struct {
+		Replicas   int
+		Min        int
+		Max        int
+		MinChanged bool
+		MaxChanged bool
+		Expected   string
+	}{
+		{3, 1, 5, true, true, ""},
+		{0, 0, 3, false, false, ""},
+		{3, 3, 3, false, true, ""},
+		{3, 6, 5, true, false, "min_replicas changed to 6, must not be bigger than max_replicas 5"},
+		{3, 2, 1, false, true, "max_replicas changed to 1, must not be smaller than min_replicas 2"},
+		{3, 6, 5, true, true, "min_replicas 6 must not be bigger than max_replicas 5"},
+		{6, 1, 5, false, true, "max_replicas 5 can't be smaller than current replicas 6"},
+		{1, 2, 5, true, false, "min_replicas 2 can't be bigger than current replicas 1"},
+	}
+	for _, tc := range cases {
+		err := validateAutoscalerRange(tc.Replicas, tc.Min, tc.Max, tc.MinChanged, tc.MaxChanged)
+		if tc.Expected == "" {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tc.Expected {
+			t.Fatalf("want error %q, got: %v", tc.Expected, err)
+		}
+	}
+}
+
+func TestDiagnoseAWSSubnet(t *testing.T) {
+	subnets := models.AWSSubnetList{
+		{ID: "subnet-a", AvailabilityZone: "eu-central-1a"},
+		{ID: "subnet-b", AvailabilityZone: "eu-central-1b"},
+	}
+	cases := []struct {
+		SubnetID         string
+		AvailabilityZone string
+		ExpectedPath     *string
+	}{
+		{"subnet-a", "eu-central-1a", nil},
+		{"subnet-b", "", nil},
+		{"subnet-a", "eu-central-1b", strToPtr("availability_zone")},
+		{"subnet-c", "eu-central-1a", strToPtr("subnet_id")},
+	}
+	for _, tc := range cases {
+		diags := diagnoseAWSSubnet(tc.SubnetID, tc.AvailabilityZone, subnets)
+		if tc.ExpectedPath == nil {
+			if diags != nil {
+				t.Fatalf("unexpected diagnostics for %s/%s: %v", tc.SubnetID, tc.AvailabilityZone, diags)
+			}
+			continue
+		}
+		if len(diags) != 1 || !diags[0].AttributePath.Equals(nodeDeploymentCloudAttrPath("aws", *tc.ExpectedPath)) {
+			t.Fatalf("expected error for %s attribute, got: %v", *tc.ExpectedPath, diags)
+		}
+	}
+}
@@ -23,5 +23,6 @@ func sharedConfigForRegion(_ string) (*metakubeProviderMeta, error) {
 		client,
 		auth,
 		log,
+		"",
 	}, nil
 }